@@ -0,0 +1,439 @@
+/*
+ * Binary wire format serialization for the generated messages. The generated
+ * code reads and writes the protocol buffer wire format with the
+ * jspb.BinaryReader and jspb.BinaryWriter classes of the protobuf Closure
+ * library.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The suffixes of the jspb.BinaryReader and jspb.BinaryWriter methods
+// (readInt32, writePackedInt32, ...) for each field type.
+var binaryMethods = map[descriptor.FieldDescriptorProto_Type]string{
+	descriptor.FieldDescriptorProto_TYPE_DOUBLE:   "Double",
+	descriptor.FieldDescriptorProto_TYPE_FLOAT:    "Float",
+	descriptor.FieldDescriptorProto_TYPE_INT64:    "Int64",
+	descriptor.FieldDescriptorProto_TYPE_UINT64:   "Uint64",
+	descriptor.FieldDescriptorProto_TYPE_INT32:    "Int32",
+	descriptor.FieldDescriptorProto_TYPE_FIXED64:  "Fixed64",
+	descriptor.FieldDescriptorProto_TYPE_FIXED32:  "Fixed32",
+	descriptor.FieldDescriptorProto_TYPE_BOOL:     "Bool",
+	descriptor.FieldDescriptorProto_TYPE_STRING:   "String",
+	descriptor.FieldDescriptorProto_TYPE_MESSAGE:  "Message",
+	descriptor.FieldDescriptorProto_TYPE_BYTES:    "Bytes",
+	descriptor.FieldDescriptorProto_TYPE_UINT32:   "Uint32",
+	descriptor.FieldDescriptorProto_TYPE_ENUM:     "Enum",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED32: "Sfixed32",
+	descriptor.FieldDescriptorProto_TYPE_SFIXED64: "Sfixed64",
+	descriptor.FieldDescriptorProto_TYPE_SINT32:   "Sint32",
+	descriptor.FieldDescriptorProto_TYPE_SINT64:   "Sint64",
+}
+
+// isPacked returns whether the repeated field is written in the packed
+// encoding. Only scalar numeric fields, whose wire type isn't "bytes", can be
+// packed; they are packed by default in proto3 and on request in proto2.
+func isPacked(field *descriptor.FieldDescriptorProto, wire string, proto3 bool) bool {
+	if !isRepeated(field) || wire == "bytes" {
+		return false
+	}
+	if field.GetOptions() != nil && field.GetOptions().Packed != nil {
+		return field.GetOptions().GetPacked()
+	}
+	return proto3
+}
+
+// generateBinary generates the binary serialization methods of the message:
+// serializeBinary() and the static deserializeBinary(bytes), plus the static
+// serializeBinaryToWriter and deserializeBinaryFromReader used for nested
+// messages.
+func (g *Generator) generateBinary(message *Descriptor, className string) {
+	reader, writer := g.use("jspb.BinaryReader"), g.use("jspb.BinaryWriter")
+
+	g.declare(className, "serializeBinary(): Uint8Array;")
+	g.declare(className, "static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): "+className+";")
+
+	g.P("/**")
+	g.P(" * Serializes the message to the binary wire format.")
+	g.P(" * @return {!Uint8Array} The serialized message.")
+	g.P(" */")
+	g.P(className, ".prototype.serializeBinary = function() {")
+	g.In()
+	g.P("var writer = new ", writer, "();")
+	g.P(className, ".serializeBinaryToWriter(this, writer);")
+	g.P("return writer.getResultBuffer();")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * Writes the message to the writer in the binary wire format.")
+	g.P(" * @param {!", className, "} message The message.")
+	g.P(" * @param {!", writer, "} writer The writer.")
+	g.P(" */")
+	g.P(className, ".serializeBinaryToWriter = function(message, writer) {")
+	g.In()
+	if len(message.Field) > 0 || isExtendable(message) {
+		g.P("var v;")
+	}
+	for _, field := range message.Field {
+		g.generateFieldWriter(message, field)
+	}
+	if isExtendable(message) {
+		g.P("for (var __number in ", className, ".extensions) {")
+		g.In()
+		g.P("var __ext = ", className, ".extensions[__number];")
+		g.P("v = message.jsonData_['[' + __ext.fullName + ']'];")
+		g.P("if (v != null) {")
+		g.In()
+		g.P("__ext.write(v, writer);")
+		g.Out()
+		g.P("}")
+		g.Out()
+		g.P("}")
+	}
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * Deserializes a message from the binary wire format.")
+	g.P(" * @param {jspb.ByteSource} bytes The serialized message.")
+	g.P(" * @return {!", className, "} The message.")
+	g.P(" */")
+	g.P(className, ".deserializeBinary = function(bytes) {")
+	g.In()
+	g.P("var reader = new ", reader, "(bytes);")
+	g.P("return ", className, ".deserializeBinaryFromReader(new ", className, "({}), reader);")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * Reads the fields of the message from the reader in the binary wire format.")
+	g.P(" * @param {!", className, "} message The message.")
+	g.P(" * @param {!", reader, "} reader The reader.")
+	g.P(" * @return {!", className, "} The message.")
+	g.P(" */")
+	g.P(className, ".deserializeBinaryFromReader = function(message, reader) {")
+	g.In()
+	g.P("while (reader.nextField()) {")
+	g.In()
+	g.P("if (reader.isEndGroup()) {")
+	g.In()
+	g.P("break;")
+	g.Out()
+	g.P("}")
+	g.P("var value;")
+	g.P("switch (reader.getFieldNumber()) {")
+	for _, field := range message.Field {
+		g.P("case ", field.Number, ":")
+		g.In()
+		g.generateFieldReader(message, field)
+		g.P("break;")
+		g.Out()
+	}
+	g.P("default:")
+	g.In()
+	if isExtendable(message) {
+		g.P("var __ext = ", className, ".extensions[reader.getFieldNumber()];")
+		g.P("if (__ext) {")
+		g.In()
+		g.P("var __key = '[' + __ext.fullName + ']';")
+		g.P("message.jsonData_[__key] = __ext.read(reader, message.jsonData_[__key]);")
+		g.Out()
+		g.P("} else {")
+		g.In()
+		g.P("reader.skipField();")
+		g.Out()
+		g.P("}")
+	} else {
+		g.P("reader.skipField();")
+	}
+	g.Out()
+	g.P("}")
+	g.Out()
+	g.P("}")
+	g.P("return message;")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// generateFieldWriter generates the statements writing the field of the
+// message held by the "message" variable to the "writer" variable.
+func (g *Generator) generateFieldWriter(message *Descriptor, field *descriptor.FieldDescriptorProto) {
+	g.P(fmt.Sprintf("v = message.jsonData_[\"%s\"];", g.jsonKey(field)))
+	g.P("if (v != null) {")
+	g.In()
+	g.generateJSONWriter(message, field)
+	g.Out()
+	g.P("}")
+}
+
+// generateJSONWriter generates the statements writing the field, given the
+// JSON data of its value in the "v" variable, to the "writer" variable.
+func (g *Generator) generateJSONWriter(message *Descriptor, field *descriptor.FieldDescriptorProto) {
+	_, eleTyp, wire := g.JsType(message, field)
+	method := g.binaryMethod(field)
+
+	switch {
+	case g.mapEntry(field) != nil:
+		entry := g.mapEntry(field)
+		keyField, valField := entry.Field[0], entry.Field[1]
+		g.P("for (var __key in v) {")
+		g.In()
+		g.P("writer.writeMessage(", field.Number, ", __key, function(__key, writer) {")
+		g.In()
+		if g.int64AsString(keyField) {
+			g.P("writer.write", g.binaryMethod(keyField), "(1, __key);")
+		} else {
+			g.P("writer.write", g.binaryMethod(keyField), "(1, ", g.mapKeyFromString(keyField, "__key"), ");")
+		}
+		g.generateValueWriter(entry, valField, "v[__key]")
+		g.Out()
+		g.P("});")
+		g.Out()
+		g.P("}")
+	case eleTyp != "":
+		g.P(g.forEach("v", "__item"))
+		g.In()
+		g.P("writer.writeMessage(", field.Number, ", new ", eleTyp, "(__item), ", eleTyp, ".serializeBinaryToWriter);")
+		g.Out()
+		g.P("}, this);")
+	case isRepeated(field) && wellKnownType(field) != "":
+		g.P(g.forEach("v", "__item"))
+		g.In()
+		g.generateWktWriter(field, "__item")
+		g.Out()
+		g.P("}, this);")
+	case isRepeated(field):
+		if conv := g.binaryFromJSON(field, "__v"); conv != "__v" {
+			g.P("v = v.map(function(__v) {")
+			g.In()
+			g.P("return ", conv, ";")
+			g.Out()
+			g.P("});")
+		}
+		if isPacked(field, wire, g.file.proto3) {
+			g.P("writer.writePacked", method, "(", field.Number, ", v);")
+		} else {
+			g.P("writer.writeRepeated", method, "(", field.Number, ", v);")
+		}
+	default:
+		g.generateValueWriter(message, field, "v")
+	}
+}
+
+// generateValueWriter generates the statement writing expr, the JSON form of
+// a singular field, to the "writer" variable.
+func (g *Generator) generateValueWriter(message *Descriptor, field *descriptor.FieldDescriptorProto, expr string) {
+	if wellKnownType(field) != "" {
+		g.generateWktWriter(field, expr)
+		return
+	}
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		typ, _, _ := g.JsType(message, field)
+		g.P("writer.writeMessage(", field.Number, ", new ", typ, "(", expr, "), ", typ, ".serializeBinaryToWriter);")
+		return
+	}
+	g.P("writer.write", g.binaryMethod(field), "(", field.Number, ", ", g.binaryFromJSON(field, expr), ");")
+}
+
+// generateFieldReader generates the statements reading the field at the
+// current position of the "reader" variable into the "message" variable.
+func (g *Generator) generateFieldReader(message *Descriptor, field *descriptor.FieldDescriptorProto) {
+	if inRealOneof(field) {
+		// The last member of a oneof on the wire wins.
+		for _, f := range oneofMembers(message, field.GetOneofIndex()) {
+			if f == field {
+				continue
+			}
+			g.P(fmt.Sprintf("delete message.jsonData_[\"%s\"];", g.jsonKey(f)))
+			if isMessage(f) {
+				g.P(fmt.Sprintf("message.%s_ = undefined;", f.GetName()))
+			}
+		}
+	}
+	g.generateJSONReader(message, field, fmt.Sprintf("message.jsonData_[\"%s\"]", g.jsonKey(field)))
+	if isMessage(field) && !isRepeated(field) {
+		g.P(fmt.Sprintf("message.%s_ = undefined;", field.GetName()))
+	}
+}
+
+// generateJSONReader generates the statements reading the field at the
+// current position of the "reader" variable into lhs, the JSON data of its
+// value. Repeated fields and maps add to the previous value of lhs.
+func (g *Generator) generateJSONReader(message *Descriptor, field *descriptor.FieldDescriptorProto, lhs string) {
+	_, eleTyp, wire := g.JsType(message, field)
+	method := g.binaryMethod(field)
+
+	switch {
+	case g.mapEntry(field) != nil:
+		entry := g.mapEntry(field)
+		keyField, valField := entry.Field[0], entry.Field[1]
+		g.P("value = {key: ", g.binaryZeroValue(keyField), ", value: ", g.binaryZeroValue(valField), "};")
+		g.P("reader.readMessage(value, function(entry, reader) {")
+		g.In()
+		g.P("var __value;")
+		g.P("while (reader.nextField()) {")
+		g.In()
+		g.P("if (reader.isEndGroup()) {")
+		g.In()
+		g.P("break;")
+		g.Out()
+		g.P("}")
+		g.P("switch (reader.getFieldNumber()) {")
+		g.P("case 1:")
+		g.In()
+		g.P("entry.key = reader.read", g.binaryMethod(keyField), "();")
+		g.P("break;")
+		g.Out()
+		g.P("case 2:")
+		g.In()
+		g.generateValueReader(entry, valField, "entry.value", "__value")
+		g.P("break;")
+		g.Out()
+		g.P("default:")
+		g.In()
+		g.P("reader.skipField();")
+		g.Out()
+		g.P("}")
+		g.Out()
+		g.P("}")
+		g.Out()
+		g.P("});")
+		g.P(lhs, " = ", lhs, " || {};")
+		g.P(lhs, "[String(value.key)] = value.value;")
+	case eleTyp != "":
+		g.P("value = new ", eleTyp, "({});")
+		g.P("reader.readMessage(value, ", eleTyp, ".deserializeBinaryFromReader);")
+		g.P(lhs, " = ", lhs, " || [];")
+		g.P(lhs, ".push(value.getJsonData());")
+	case isRepeated(field) && wellKnownType(field) != "":
+		g.P("value = ", g.wktReadValue(field), ";")
+		g.P(lhs, " = ", lhs, " || [];")
+		g.P(lhs, ".push(value);")
+	case isRepeated(field):
+		if wire != "bytes" {
+			// Packed and unpacked encodings are both accepted.
+			g.P("value = reader.isDelimited() ? reader.readPacked", method, "() : [reader.read", method, "()];")
+		} else {
+			g.P("value = [", g.binaryReadValue(field), "];")
+		}
+		if conv := g.binaryToJSON(field, "__v"); conv != "__v" {
+			g.P("value = value.map(function(__v) {")
+			g.In()
+			g.P("return ", conv, ";")
+			g.Out()
+			g.P("});")
+		}
+		g.P(lhs, " = (", lhs, " || []).concat(value);")
+	default:
+		g.generateValueReader(message, field, lhs, "value")
+	}
+}
+
+// generateValueReader generates the statements reading a singular field at
+// the current position of the "reader" variable and assigning its JSON form
+// to lhs. The tmp variable holds the value read.
+func (g *Generator) generateValueReader(message *Descriptor, field *descriptor.FieldDescriptorProto, lhs, tmp string) {
+	if wellKnownType(field) != "" {
+		g.P(lhs, " = ", g.wktReadValue(field), ";")
+		return
+	}
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		typ, _, _ := g.JsType(message, field)
+		g.P(tmp, " = new ", typ, "({});")
+		g.P("reader.readMessage(", tmp, ", ", typ, ".deserializeBinaryFromReader);")
+		g.P(lhs, " = ", tmp, ".getJsonData();")
+		return
+	}
+	g.P(tmp, " = ", g.binaryReadValue(field), ";")
+	g.P(lhs, " = ", g.binaryToJSON(field, tmp), ";")
+}
+
+// binaryReadValue returns the JavaScript expression reading a single value
+// of the non-message field from the "reader" variable. Bytes are kept as
+// base64 strings, the same as in the JSON data.
+func (g *Generator) binaryReadValue(field *descriptor.FieldDescriptorProto) string {
+	if field.GetType() == descriptor.FieldDescriptorProto_TYPE_BYTES {
+		return g.bytesToBase64() + "(reader.readBytes())"
+	}
+	return "reader.read" + g.binaryMethod(field) + "()"
+}
+
+// bytesToBase64 returns the JavaScript function encoding a Uint8Array into a
+// base64 string.
+func (g *Generator) bytesToBase64() string {
+	if g.Target == targetESM {
+		return g.use("jspb.Message") + ".bytesAsB64"
+	}
+	return g.use("goog.crypt.base64") + ".encodeByteArray"
+}
+
+// base64ToBytes returns the JavaScript function decoding a base64 string into
+// a Uint8Array.
+func (g *Generator) base64ToBytes() string {
+	if g.Target == targetESM {
+		return g.use("jspb.Message") + ".bytesAsU8"
+	}
+	return g.use("goog.crypt.base64") + ".decodeStringToUint8Array"
+}
+
+// binaryMethod returns the suffix of the reader and writer methods of the
+// field. The 64-bit integers kept as strings are read and written by the
+// methods taking decimal strings, like readInt64String.
+func (g *Generator) binaryMethod(field *descriptor.FieldDescriptorProto) string {
+	if g.int64AsString(field) {
+		return binaryMethods[field.GetType()] + "String"
+	}
+	return binaryMethods[field.GetType()]
+}
+
+// binaryFromJSON returns the JavaScript expression converting expr, a single
+// value of the field as stored in the JSON data, into the value passed to the
+// writer methods of the field.
+func (g *Generator) binaryFromJSON(field *descriptor.FieldDescriptorProto, expr string) string {
+	if g.int64AsString(field) {
+		return "String(" + expr + ")"
+	}
+	return g.fromJSON(field, expr)
+}
+
+// binaryToJSON is the reverse of binaryFromJSON: it returns the JavaScript
+// expression converting expr, a value returned by the reader methods of the
+// field, into its JSON form.
+func (g *Generator) binaryToJSON(field *descriptor.FieldDescriptorProto, expr string) string {
+	if g.int64AsString(field) {
+		return expr
+	}
+	return g.toJSON(field, expr)
+}
+
+// binaryZeroValue returns the JSON form of the zero value of a map key or
+// value field, used when the field is missing from a map entry on the wire.
+func (g *Generator) binaryZeroValue(field *descriptor.FieldDescriptorProto) string {
+	if g.int64AsString(field) {
+		return "'0'"
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING, descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return "''"
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return "false"
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		if wellKnownType(field) != "" {
+			return "null"
+		}
+		return "{}"
+	}
+	return "0"
+}
@@ -0,0 +1,205 @@
+/*
+ * TypeScript declarations for the generated JavaScript code. The declarations
+ * are collected while the JavaScript code is generated, and are written to a
+ * .pb.d.ts file next to the .pb.js file.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"bytes"
+	"strings"
+)
+
+// tsDecl is the TypeScript declaration of a class, an enum or a typedef
+// generated in the JavaScript code of the current file.
+type tsDecl struct {
+	name    string   // The fully qualified JavaScript name.
+	kind    string   // "class", "enum", "interface", "const" or "function".
+	members []string // The declarations of the members, in TypeScript syntax, the type of a const or the signature of a function.
+}
+
+// declareClass starts the TypeScript declaration of a generated class.
+func (g *Generator) declareClass(name string) {
+	g.tsDecls = append(g.tsDecls, &tsDecl{name: name, kind: "class"})
+}
+
+// declareEnum starts the TypeScript declaration of a generated enum.
+func (g *Generator) declareEnum(name string) {
+	g.tsDecls = append(g.tsDecls, &tsDecl{name: name, kind: "enum"})
+}
+
+// declareInterface starts the TypeScript declaration of a generated record
+// typedef.
+func (g *Generator) declareInterface(name string) {
+	g.tsDecls = append(g.tsDecls, &tsDecl{name: name, kind: "interface"})
+}
+
+// declareConst declares a generated constant of the given TypeScript type.
+func (g *Generator) declareConst(name, typ string) {
+	g.tsDecls = append(g.tsDecls, &tsDecl{name: name, kind: "const", members: []string{typ}})
+}
+
+// declareFunction declares a generated function of the given TypeScript
+// signature, like "(a: string): void".
+func (g *Generator) declareFunction(name, signature string) {
+	g.tsDecls = append(g.tsDecls, &tsDecl{name: name, kind: "function", members: []string{signature}})
+}
+
+// declare adds a member, in TypeScript syntax, to the declaration of the
+// named class, enum or interface.
+func (g *Generator) declare(name, member string) {
+	for _, d := range g.tsDecls {
+		if d.name == name {
+			d.members = append(d.members, member)
+			return
+		}
+	}
+	g.Fail("internal error: no TypeScript declaration for", name)
+}
+
+// generateDts returns the TypeScript declarations of the current file. For
+// Closure scripts, the classes and enums are declared in the namespaces given
+// by their goog.provide names, so the .pb.d.ts files describe the global
+// objects set up by the .pb.js files. For ES modules they are declared as the
+// exports of the modules, and for goog.modules as the exports of ambient
+// modules named "goog:" followed by the module names.
+func (g *Generator) generateDts() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("// Code generated by protoc-gen-js.\n")
+	buf.WriteString("// source: " + g.file.GetName() + "\n")
+	buf.WriteString("// DO NOT EDIT!\n")
+
+	base, export := "", ""
+	switch g.Target {
+	case targetESM:
+		export = "export "
+		if len(g.imports) > 0 {
+			buf.WriteString("\n")
+			for _, f := range g.importedFiles() {
+				buf.WriteString("import * as " + g.imports[f] + " from '" + g.importPath(f) + "';\n")
+			}
+			for _, index := range g.file.PublicDependency {
+				buf.WriteString("export * from '" + g.importPath(g.fileByName(g.file.Dependency[index])) + "';\n")
+			}
+		}
+	case targetGoogModule:
+		base, export = "\t", "export "
+		buf.WriteString("\ndeclare module 'goog:" + g.moduleName(g.file) + "' {\n")
+		for _, f := range g.importedFiles() {
+			buf.WriteString(base + "import * as " + g.imports[f] + " from 'goog:" + g.moduleName(f) + "';\n")
+		}
+		for _, id := range g.file.imp {
+			name := CamelCaseSlice(id.TypeName())
+			buf.WriteString(base + "export import " + name + " = " + g.jsName(id) + ";\n")
+		}
+	}
+
+	// Group the declarations by namespace, in the order of appearance.
+	var namespaces []string
+	byNamespace := make(map[string][]*tsDecl)
+	for _, d := range g.tsDecls {
+		ns := ""
+		if i := strings.LastIndex(d.name, "."); i >= 0 {
+			ns = d.name[:i]
+		}
+		if _, ok := byNamespace[ns]; !ok {
+			namespaces = append(namespaces, ns)
+		}
+		byNamespace[ns] = append(byNamespace[ns], d)
+	}
+
+	for _, ns := range namespaces {
+		buf.WriteString("\n")
+		indent, declare := base, export+"declare "
+		if g.Target == targetGoogModule {
+			// Declarations in an ambient module are already ambient.
+			declare = export
+		}
+		if ns != "" {
+			buf.WriteString(base + declare + "namespace " + ns + " {\n")
+			indent, declare = base+"\t", ""
+		}
+		for i, d := range byNamespace[ns] {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			name := d.name[strings.LastIndex(d.name, ".")+1:]
+			if d.kind == "const" {
+				buf.WriteString(indent + declare + "const " + name + ": " + d.members[0] + ";\n")
+				continue
+			}
+			if d.kind == "function" {
+				buf.WriteString(indent + declare + "function " + name + d.members[0] + ";\n")
+				continue
+			}
+			buf.WriteString(indent + declare + d.kind + " " + name + " {\n")
+			for _, m := range d.members {
+				buf.WriteString(indent + "\t" + m + "\n")
+			}
+			buf.WriteString(indent + "}\n")
+		}
+		if ns != "" {
+			buf.WriteString(base + "}\n")
+		}
+	}
+	if g.Target == targetGoogModule {
+		buf.WriteString("}\n")
+	}
+	return buf.String()
+}
+
+// tsType converts a Closure type expression, as used in the JSDoc of the
+// generated code, into a TypeScript type.
+func tsType(typ string) string {
+	typ = strings.TrimSpace(typ)
+	switch {
+	case strings.HasPrefix(typ, "!"):
+		return tsType(typ[1:])
+	case strings.HasPrefix(typ, "?"):
+		return tsType(typ[1:]) + " | null"
+	case typ == "*":
+		return "any"
+	case typ == libAlias(int64Long):
+		// The alias of goog.math.Long in a goog.module.
+		return int64Long
+	case strings.HasSuffix(typ, ">") && strings.Contains(typ, ".<"):
+		i := strings.Index(typ, ".<")
+		base, args := typ[:i], splitTypeArgs(typ[i+2:len(typ)-1])
+		for j := range args {
+			args[j] = tsType(args[j])
+		}
+		if base == "Object" && len(args) == 2 {
+			return "{[key: " + args[0] + "]: " + args[1] + "}"
+		}
+		return base + "<" + strings.Join(args, ", ") + ">"
+	}
+	return typ
+}
+
+// splitTypeArgs splits the comma-separated type arguments of a generic type,
+// ignoring the commas of nested type arguments.
+func splitTypeArgs(s string) (args []string) {
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '<', '{', '(':
+			depth++
+		case '>', '}', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(args, strings.TrimSpace(s[start:]))
+}
+
+// Given a .proto file name, return the output name for the TypeScript declarations.
+func dtsFileName(name string) string {
+	return strings.TrimSuffix(jsFileName(name), ".js") + ".d.ts"
+}
@@ -0,0 +1,257 @@
+/*
+ * Comparison and copying of the generated messages: equals() compares two
+ * messages field by field, with the semantics of protocol buffers rather than
+ * of their JSON data, and clone() and deepCopy() copy the JSON data, which the
+ * wrappers otherwise share.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// equalsFunc returns the expression of the function comparing two values of
+// the singular field, or of the elements or the map values of the repeated
+// field, or "" if they are compared with ===.
+func (g *Generator) equalsFunc(field *descriptor.FieldDescriptorProto) string {
+	if value := wrappedField(wellKnownType(field)); value != nil {
+		// The wrappers are null or the wrapped values.
+		field = value
+	}
+	switch wellKnownType(field) {
+	case "":
+	case "google.protobuf.Timestamp":
+		return g.helper("dateEquals")
+	case "google.protobuf.Duration":
+		return ""
+	default:
+		return g.helper("jsonEquals")
+	}
+	switch {
+	case isMessage(field):
+		return g.helper("messageEquals")
+	case g.int64Type(field) == int64Long:
+		return g.helper("longEquals")
+	case field.GetType() == descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		field.GetType() == descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return g.helper("floatEquals")
+	}
+	return ""
+}
+
+// fieldDiffers returns the condition of the values of the field differing in
+// the messages a and b, given the name of the getter of the field. The values
+// are those of the getters, so an unset field equals a field set to its
+// default.
+func (g *Generator) fieldDiffers(field *descriptor.FieldDescriptorProto, getter, a, b string) string {
+	av, bv := a+"."+getter+"()", b+"."+getter+"()"
+	eq := g.equalsFunc(field)
+	if entry := g.mapEntry(field); entry != nil {
+		eq = g.equalsFunc(entry.Field[1])
+	}
+	switch {
+	case g.mapEntry(field) != nil:
+		return "!" + g.helper("objectEquals") + "(" + av + ", " + bv + ", " + orNull(eq) + ")"
+	case isRepeated(field):
+		return "!" + g.helper("arrayEquals") + "(" + av + ", " + bv + ", " + orNull(eq) + ")"
+	case eq != "":
+		return "!" + eq + "(" + av + ", " + bv + ")"
+	}
+	return av + " !== " + bv
+}
+
+// generateEquals generates the equals, deepCopy and clone methods of the
+// message, given the names of the getters of its fields and of the presence
+// checks of the fields with presence.
+func (g *Generator) generateEquals(message *Descriptor, className string, getters, hasNames map[*descriptor.FieldDescriptorProto]string) {
+	g.declare(className, "equals(other: any): boolean;")
+	g.declare(className, "deepCopy(): Object;")
+	g.declare(className, "clone(): "+className+";")
+
+	g.P("/**")
+	g.P(" * Compares the message with another one field by field. Unset fields equal")
+	g.P(" * fields set to their defaults, even when the fields track their presence,")
+	g.P(" * and NaN equals NaN.")
+	g.P(" * @param {*} other The other message.")
+	g.P(" * @return {boolean} Whether the messages are equal.")
+	g.P(" */")
+	g.P(className, ".prototype.equals = function(other) {")
+	g.In()
+	g.P("if (!(other instanceof ", className, ")) {")
+	g.In()
+	g.P("return false;")
+	g.Out()
+	g.P("}")
+	for _, field := range message.Field {
+		cond := g.fieldDiffers(field, getters[field], "this", "other")
+		g.P("if (", cond, ") {")
+		g.In()
+		g.P("return false;")
+		g.Out()
+		g.P("}")
+	}
+	if isExtendable(message) {
+		g.P("return ", g.helper("extensionsEqual"), "(this.jsonData_, other.jsonData_);")
+	} else {
+		g.P("return true;")
+	}
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * @return {!Object} A deep copy of the JSON data of the message.")
+	g.P(" */")
+	g.P(className, ".prototype.deepCopy = function() {")
+	g.In()
+	g.P("return ", g.helper("copyJSON"), "(this.jsonData_);")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * @return {!", className, "} A copy of the message, sharing no data with it.")
+	g.P(" */")
+	g.P(className, ".prototype.clone = function() {")
+	g.In()
+	g.P("return new ", className, "(this.deepCopy());")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// The helper functions of equals, deepCopy and clone.
+var equalsHelpers = map[string]jsHelper{
+	"floatEquals": {
+		doc: `Compares two floating point numbers, NaN being equal to NaN.
+@param {?number} a The first number.
+@param {?number} b The second number.
+@return {boolean} Whether the numbers are equal.`,
+		params: "a, b",
+		body:   `return a === b || (a !== a && b !== b);`,
+	},
+	"longEquals": {
+		doc: `Compares two goog.math.Long values, or nulls.
+@param {?$(goog.math.Long)} a The first value.
+@param {?$(goog.math.Long)} b The second value.
+@return {boolean} Whether the values are equal.`,
+		params: "a, b",
+		body:   `return a == null ? b == null : b != null && a.equals(b);`,
+	},
+	"dateEquals": {
+		doc: `Compares two dates, or nulls.
+@param {?Date} a The first date.
+@param {?Date} b The second date.
+@return {boolean} Whether the dates are equal.`,
+		params: "a, b",
+		body:   `return a == null ? b == null : b != null && a.getTime() === b.getTime();`,
+	},
+	"messageEquals": {
+		doc: `Compares two messages, or unset messages.
+@param {?{equals: function(*): boolean}|undefined} a The first message.
+@param {?{equals: function(*): boolean}|undefined} b The second message.
+@return {boolean} Whether the messages are equal.`,
+		params: "a, b",
+		body:   `return a == null ? b == null : b != null && a.equals(b);`,
+	},
+	"arrayEquals": {
+		doc: `Compares two arrays element by element.
+@param {!Array} a The first array.
+@param {!Array} b The second array.
+@param {?function(*, *): boolean} eq The comparison of the elements, or null
+    to compare them with ===.
+@return {boolean} Whether the arrays are equal.`,
+		params: "a, b, eq",
+		body: `if (a.length !== b.length) {
+	return false;
+}
+for (var i = 0; i < a.length; i++) {
+	if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+		return false;
+	}
+}
+return true;`,
+	},
+	"objectEquals": {
+		doc: `Compares the values of two maps, as objects, key by key.
+@param {!Object} a The first map.
+@param {!Object} b The second map.
+@param {?function(*, *): boolean} eq The comparison of the values, or null to
+    compare them with ===.
+@return {boolean} Whether the maps are equal.`,
+		params: "a, b, eq",
+		body: `var keys = Object.keys(a);
+if (keys.length !== Object.keys(b).length) {
+	return false;
+}
+for (var i = 0; i < keys.length; i++) {
+	var key = keys[i];
+	if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+		return false;
+	}
+}
+return true;`,
+	},
+	"jsonEquals": {
+		doc: `Compares two JSON values deeply, NaN being equal to NaN.
+@param {*} a The first value.
+@param {*} b The second value.
+@return {boolean} Whether the values are equal.`,
+		params: "a, b",
+		body: `if (a === b || (a !== a && b !== b)) {
+	return true;
+}
+if (a === null || b === null || typeof a !== 'object' || typeof b !== 'object' ||
+		Array.isArray(a) !== Array.isArray(b)) {
+	return false;
+}
+var keys = Object.keys(a);
+if (keys.length !== Object.keys(b).length) {
+	return false;
+}
+for (var i = 0; i < keys.length; i++) {
+	if (!Object.prototype.hasOwnProperty.call(b, keys[i]) || !$(jsonEquals)(a[keys[i]], b[keys[i]])) {
+		return false;
+	}
+}
+return true;`,
+	},
+	"extensionsEqual": {
+		doc: `Compares the extensions in the JSON data of two messages, the keys in
+brackets.
+@param {!Object} a The JSON data of the first message.
+@param {!Object} b The JSON data of the second message.
+@return {boolean} Whether the extensions are equal.`,
+		params: "a, b",
+		body: `var keys = Object.keys(a).concat(Object.keys(b));
+for (var i = 0; i < keys.length; i++) {
+	if (keys[i].charAt(0) === '[' && !$(jsonEquals)(a[keys[i]], b[keys[i]])) {
+		return false;
+	}
+}
+return true;`,
+	},
+	"copyJSON": {
+		doc: `Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+it keeps NaN and the infinities.
+@param {*} v The value.
+@return {?} The copy.`,
+		params: "v",
+		body: `if (Array.isArray(v)) {
+	return v.map($(copyJSON));
+}
+if (v !== null && typeof v === 'object') {
+	var copy = {};
+	for (var key in v) {
+		if (Object.prototype.hasOwnProperty.call(v, key)) {
+			copy[key] = $(copyJSON)(v[key]);
+		}
+	}
+	return copy;
+}
+return v;`,
+	},
+}
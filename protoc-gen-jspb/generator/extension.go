@@ -0,0 +1,310 @@
+/*
+ * Proto2 extensions. Every extension gets a descriptor object converting its
+ * values to and from their JSON data and the binary wire format, and the
+ * extendable messages get the accessors taking the descriptors. The values
+ * are kept in the JSON data of the extended messages under the keys of the
+ * canonical JSON mapping, the full names of the extensions in brackets.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The names of the methods of the extendable messages, reserved before the
+// names of the accessors of their fields are allocated.
+var extensionMethods = []string{"getExtension", "setExtension", "hasExtension", "clearExtension"}
+
+// The TypeScript type of the descriptors of the extensions, of the given
+// value type.
+const extensionTsType = "{readonly fieldNumber: number; readonly fullName: string; readonly extendee: string; " +
+	"fromJSON(v: any): %s; toJSON(v: %s): any; write(v: any, writer: any): void; read(reader: any, v: any): any}"
+
+// isExtendable returns whether the message declares extension ranges.
+func isExtendable(message *Descriptor) bool {
+	return len(message.ExtensionRange) > 0
+}
+
+// The static members of the generated classes, and the properties of the
+// functions, which the descriptors of the extensions declared in the messages
+// must not overwrite.
+var staticNames = map[string]bool{
+	"TYPE_NAME":                   true,
+	"deserializeBinary":           true,
+	"deserializeBinaryFromReader": true,
+	"serializeBinaryToWriter":     true,
+	"verify":                      true,
+	"extensions":                  true,
+	"prototype":                   true,
+	"name":                        true,
+	"length":                      true,
+	"call":                        true,
+	"apply":                       true,
+	"bind":                        true,
+}
+
+// The functions generated at the top level of the files, which the
+// descriptors of the extensions declared there must not overwrite.
+var topLevelNames = map[string]bool{
+	"registerAnyTypes": true,
+}
+
+// hasGeneratedClass reports whether the message named typeName, fully
+// qualified, has a generated class. The messages of google/protobuf, like the
+// options of descriptor.proto and the well-known types, have none.
+func hasGeneratedClass(typeName string) bool {
+	return !strings.HasPrefix(typeName, ".google.protobuf.")
+}
+
+// extensionName returns the JavaScript name of the descriptor of the
+// extension declared in the scope of the message, or at the top level of the
+// current file if scope is nil: the lowerCamelCase name of the field, with an
+// underscore appended if it's the name of a generated static or function.
+func (g *Generator) extensionName(scope *Descriptor, field *descriptor.FieldDescriptorProto) string {
+	name := CamelCase(field.GetName())
+	name = strings.ToLower(name[:1]) + name[1:]
+	if scope != nil {
+		if staticNames[name] {
+			name += "_"
+		}
+		return g.jsName(scope) + "." + name
+	}
+	if topLevelNames[name] {
+		name += "_"
+	}
+	return g.topLevelName(name)
+}
+
+// generateExtensions generates the descriptors of the extensions declared in
+// the current file, at the top level and in the messages.
+func (g *Generator) generateExtensions() {
+	for i, field := range g.file.Extension {
+		g.generateExtension(nil, field, fmt.Sprintf("%d,%d", extensionPath, i))
+	}
+	for _, desc := range g.file.desc {
+		for i, field := range desc.Extension {
+			g.generateExtension(desc, field, fmt.Sprintf("%s,%d,%d", desc.path, messageExtensionPath, i))
+		}
+	}
+}
+
+// generateExtension generates the descriptor of the extension declared in
+// the scope of the message, or at the top level if scope is nil, and
+// registers it with the extended message if it has a generated class.
+func (g *Generator) generateExtension(scope *Descriptor, field *descriptor.FieldDescriptorProto, path string) {
+	g.path = path
+	name := g.extensionName(scope, field)
+	fullName := field.GetName()
+	if scope != nil {
+		fullName = dottedSlice(scope.TypeName()) + "." + fullName
+	}
+	if pkg := g.file.GetPackage(); pkg != "" {
+		fullName = pkg + "." + fullName
+	}
+	typename, eleTyp, _ := g.JsType(scope, field)
+	def := g.zeroValue(field, typename, g.file.proto3)
+	if field.DefaultValue != nil {
+		def = g.defaultValue(field)
+	}
+
+	tsDecl := fmt.Sprintf(extensionTsType, tsType(typename), tsType(typename))
+	if scope != nil {
+		g.declare(g.jsName(scope), fmt.Sprintf("static %s: %s;", name[strings.LastIndex(name, ".")+1:], tsDecl))
+	} else {
+		g.declareConst(name, tsDecl)
+	}
+
+	g.P("/**")
+	if g.PrintComments(path) {
+		g.P(" *")
+	}
+	g.P(" * The ", field.GetName(), " extension of ", strings.TrimPrefix(field.GetExtendee(), "."), ".")
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" * @const")
+	g.P(" */")
+	if scope != nil {
+		g.P(name, " = {")
+	} else {
+		g.P(g.define(name), " = {")
+	}
+	g.In()
+	g.P("fieldNumber: ", field.Number, ",")
+	g.P("fullName: '", fullName, "',")
+	g.P("extendee: '", strings.TrimPrefix(field.GetExtendee(), "."), "',")
+
+	g.P("/**")
+	g.P(" * @param {*} v The JSON data of the value.")
+	g.P(" * @return {", typename, "} The value, or the default if unset.")
+	g.P(" */")
+	g.P("fromJSON: function(v) {")
+	g.In()
+	switch {
+	case eleTyp != "":
+		g.P("return (v || []).map(function(__item) {")
+		g.In()
+		g.P("return new ", eleTyp, "(__item);")
+		g.Out()
+		g.P("});")
+	case isMessage(field):
+		g.P("return v != null ? new ", typename, "(v) : ", def, ";")
+	case isRepeated(field) && g.fromJSON(field, "__v") != "__v":
+		g.P("return (v || []).map(function(__v) {")
+		g.In()
+		g.P("return ", g.fromJSON(field, "__v"), ";")
+		g.Out()
+		g.P("});")
+	case isRepeated(field):
+		g.P("return v || [];")
+	default:
+		g.P("return v != null ? ", g.fromJSON(field, "v"), " : ", def, ";")
+	}
+	g.Out()
+	g.P("},")
+
+	g.P("/**")
+	g.P(" * @param {", typename, "} v The value.")
+	g.P(" * @return {*} The JSON data of the value.")
+	g.P(" */")
+	g.P("toJSON: function(v) {")
+	g.In()
+	switch {
+	case eleTyp != "":
+		g.P("return v.map(function(__item) {")
+		g.In()
+		g.P("return __item.getJsonData();")
+		g.Out()
+		g.P("});")
+	case isMessage(field):
+		g.P("return v.getJsonData();")
+	case isRepeated(field) && g.toJSON(field, "__v") != "__v":
+		g.P("return v.map(function(__v) {")
+		g.In()
+		g.P("return ", g.toJSON(field, "__v"), ";")
+		g.Out()
+		g.P("});")
+	default:
+		g.P("return ", g.toJSON(field, "v"), ";")
+	}
+	g.Out()
+	g.P("},")
+
+	g.P("/**")
+	g.P(" * @param {*} v The JSON data of the value.")
+	g.P(" * @param {!", g.use("jspb.BinaryWriter"), "} writer The writer.")
+	g.P(" */")
+	g.P("write: function(v, writer) {")
+	g.In()
+	g.generateJSONWriter(scope, field)
+	g.Out()
+	g.P("},")
+
+	g.P("/**")
+	g.P(" * @param {!", g.use("jspb.BinaryReader"), "} reader The reader.")
+	g.P(" * @param {*} v The JSON data of the previous value.")
+	g.P(" * @return {*} The JSON data of the value.")
+	g.P(" */")
+	g.P("read: function(reader, v) {")
+	g.In()
+	g.P("var value;")
+	g.generateJSONReader(scope, field, "v")
+	g.P("return v;")
+	g.Out()
+	g.P("}")
+	g.Out()
+	g.P("};")
+	if hasGeneratedClass(field.GetExtendee()) {
+		g.P(g.jsName(g.objectNamed(field.GetExtendee())), ".extensions[", field.Number, "] = ", name, ";")
+	}
+	g.P()
+}
+
+// generateExtendable generates the registry of the extensions of the
+// extendable message and the accessors of their values.
+func (g *Generator) generateExtendable(className string) {
+	key := g.helper("extensionKey") + "(" + className + ".TYPE_NAME, ext)"
+	extType := "{fullName: string, extendee: string}"
+	tsExt := "{readonly fullName: string; readonly extendee: string"
+
+	g.declare(className, "static extensions: {[fieldNumber: number]: Object};")
+	g.declare(className, "getExtension<T>(ext: "+tsExt+"; fromJSON(v: any): T}): T;")
+	g.declare(className, "setExtension<T>(ext: "+tsExt+"; toJSON(v: T): any}, value: T): void;")
+	g.declare(className, "hasExtension(ext: "+tsExt+"}): boolean;")
+	g.declare(className, "clearExtension(ext: "+tsExt+"}): void;")
+
+	g.P("/**")
+	g.P(" * The descriptors of the extensions of the message, by field number.")
+	g.P(" * @const {!Object.<number, !Object>}")
+	g.P(" */")
+	g.P(className, ".extensions = {};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * @param {{fullName: string, extendee: string, fromJSON: function(*): T}} ext")
+	g.P(" *     The extension.")
+	g.P(" * @return {T} The value of the extension, or its default if unset.")
+	g.P(" * @template T")
+	g.P(" */")
+	g.P(className, ".prototype.getExtension = function(ext) {")
+	g.In()
+	g.P("return ext.fromJSON(this.jsonData_[", key, "]);")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * @param {{fullName: string, extendee: string, toJSON: function(T): *}} ext")
+	g.P(" *     The extension.")
+	g.P(" * @param {T} value The value of the extension.")
+	g.P(" * @template T")
+	g.P(" */")
+	g.P(className, ".prototype.setExtension = function(ext, value) {")
+	g.In()
+	g.P("this.jsonData_[", key, "] = ext.toJSON(value);")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * @param {", extType, "} ext The extension.")
+	g.P(" * @return {boolean} Whether the extension is set.")
+	g.P(" */")
+	g.P(className, ".prototype.hasExtension = function(ext) {")
+	g.In()
+	g.P("return this.jsonData_[", key, "] != null;")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * Clears the extension.")
+	g.P(" * @param {", extType, "} ext The extension.")
+	g.P(" */")
+	g.P(className, ".prototype.clearExtension = function(ext) {")
+	g.In()
+	g.P("delete this.jsonData_[", key, "];")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// The helper functions of the extensions.
+var extensionHelpers = map[string]jsHelper{
+	"extensionKey": {
+		doc: `Returns the key of the value of the extension in the JSON data of the
+extended messages, the full name of the extension in brackets.
+@param {string} typeName The full name of the type of the message.
+@param {{fullName: string, extendee: string}} ext The extension.
+@return {string} The key.`,
+		params: "typeName, ext",
+		body: `if (ext.extendee !== typeName) {
+	throw new Error(ext.fullName + ' does not extend ' + typeName);
+}
+return '[' + ext.fullName + ']';`,
+	},
+}
@@ -0,0 +1,168 @@
+/*
+ * Field masks of the generated messages. applyFieldMask() prunes the JSON data
+ * of a message to the paths of a google.protobuf.FieldMask and diffFieldMask()
+ * computes the paths of the fields differing between two messages. The paths
+ * are the dot-separated field names of the .proto files, descending into the
+ * singular message fields, as in the paths of the FieldMask fields.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// generateFieldMask generates the applyFieldMask and diffFieldMask methods of
+// the message, given the names of the getters and presence checks of its
+// fields.
+func (g *Generator) generateFieldMask(message *Descriptor, className string, getters, hasNames map[*descriptor.FieldDescriptorProto]string) {
+	g.declare(className, "applyFieldMask(paths: string[]): void;")
+	g.declare(className, "diffFieldMask(other: "+className+"): string[];")
+
+	g.P("/**")
+	g.P(" * Prunes the message to the fields of the paths, clearing the other fields.")
+	g.P(" * The paths naming fields of the singular message fields prune the messages.")
+	g.P(" * @param {!Array.<string>} paths The paths of a field mask.")
+	g.P(" */")
+	g.P(className, ".prototype.applyFieldMask = function(paths) {")
+	g.In()
+	if len(message.Field) > 0 {
+		g.P("var p;")
+	}
+	for _, field := range message.Field {
+		key := g.jsonKey(field)
+		g.P("p = ", g.helper("fieldMaskPaths"), "(paths, \"", field.GetName(), "\");")
+		g.P("if (p !== true) {")
+		g.In()
+		if isMessage(field) && !isRepeated(field) {
+			g.P("if (p.length && this.", hasNames[field], "()) {")
+			g.In()
+			g.P("this.", getters[field], "().applyFieldMask(p);")
+			g.Out()
+			g.P("} else {")
+			g.In()
+			g.P(fmt.Sprintf("delete this.jsonData_[\"%s\"];", key))
+			g.P(fmt.Sprintf("this.%s_ = undefined;", field.GetName()))
+			g.Out()
+			g.P("}")
+		} else {
+			g.P(fmt.Sprintf("delete this.jsonData_[\"%s\"];", key))
+			if isMessage(field) {
+				g.P(fmt.Sprintf("this.%s_ = undefined;", field.GetName()))
+			}
+		}
+		g.Out()
+		g.P("}")
+	}
+	if isExtendable(message) {
+		g.P(g.helper("pruneExtensions"), "(this.jsonData_, paths);")
+	}
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * Computes the paths of the fields differing between the message and another")
+	g.P(" * one, descending into the singular message fields set in both messages.")
+	g.P(" * Applied to the other message, they give the fields updated by the message.")
+	g.P(" * @param {!", className, "} other The other message.")
+	g.P(" * @return {!Array.<string>} The paths of a field mask.")
+	g.P(" */")
+	g.P(className, ".prototype.diffFieldMask = function(other) {")
+	g.In()
+	g.P("var paths = [];")
+	for _, field := range message.Field {
+		if isMessage(field) && !isRepeated(field) {
+			has, getter := hasNames[field], getters[field]
+			g.P("if (this.", has, "() && other.", has, "()) {")
+			g.In()
+			g.P(g.helper("appendPaths"), "(paths, \"", field.GetName(), ".\", this.", getter, "().diffFieldMask(other.", getter, "()));")
+			g.Out()
+			g.P("} else if (this.", has, "() !== other.", has, "()) {")
+		} else {
+			cond := g.fieldDiffers(field, getters[field], "this", "other")
+			if hasPresence(field, message.proto3()) {
+				// Applying the paths also sets or clears the field.
+				has := hasNames[field]
+				cond = "this." + has + "() !== other." + has + "() || " + cond
+			}
+			g.P("if (", cond, ") {")
+		}
+		g.In()
+		g.P("paths.push(\"", field.GetName(), "\");")
+		g.Out()
+		g.P("}")
+	}
+	if isExtendable(message) {
+		g.P(g.helper("diffExtensions"), "(paths, this.jsonData_, other.jsonData_);")
+	}
+	g.P("return paths;")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// The helper functions of the field masks.
+var fieldMaskHelpers = map[string]jsHelper{
+	"fieldMaskPaths": {
+		doc: `Looks up a field in the paths of a field mask.
+@param {!Array.<string>} paths The paths.
+@param {string} name The name of the field.
+@return {boolean|!Array.<string>} True if the paths include the field, or the
+    paths of its fields, relative to the field.`,
+		params: "paths, name",
+		body: `var sub = [];
+for (var i = 0; i < paths.length; i++) {
+	if (paths[i] === name) {
+		return true;
+	}
+	if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+		sub.push(paths[i].substring(name.length + 1));
+	}
+}
+return sub;`,
+	},
+	"appendPaths": {
+		doc: `Appends the paths of the fields of a message field to the paths of a field
+mask.
+@param {!Array.<string>} paths The paths.
+@param {string} prefix The name of the field followed by a dot.
+@param {!Array.<string>} sub The paths relative to the field.`,
+		params: "paths, prefix, sub",
+		body: `for (var i = 0; i < sub.length; i++) {
+	paths.push(prefix + sub[i]);
+}`,
+	},
+	"pruneExtensions": {
+		doc: `Deletes the extensions in the JSON data of a message, the keys in
+brackets, missing from the paths of a field mask. The paths of the extensions
+are their keys, like [foo.bar.baz].
+@param {!Object} data The JSON data.
+@param {!Array.<string>} paths The paths.`,
+		params: "data, paths",
+		body: `for (var key in data) {
+	if (key.charAt(0) === '[' && paths.indexOf(key) < 0) {
+		delete data[key];
+	}
+}`,
+	},
+	"diffExtensions": {
+		doc: `Appends the keys of the extensions differing in the JSON data of two
+messages, the keys in brackets, to the paths of a field mask.
+@param {!Array.<string>} paths The paths.
+@param {!Object} a The JSON data of the first message.
+@param {!Object} b The JSON data of the second message.`,
+		params: "paths, a, b",
+		body: `var keys = Object.keys(a).concat(Object.keys(b));
+for (var i = 0; i < keys.length; i++) {
+	var key = keys[i];
+	if (key.charAt(0) === '[' && paths.indexOf(key) < 0 && !$(jsonEquals)(a[key], b[key])) {
+		paths.push(key);
+	}
+}`,
+	},
+}
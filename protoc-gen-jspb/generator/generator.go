@@ -12,14 +12,15 @@ package generator
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
-	"log"
-	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf16"
 
 	"github.com/golang/protobuf/proto"
 
@@ -27,7 +28,7 @@ import (
 	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
 )
 
-var ErrNoPackageDefined = errors.New("no package clause in proto file.")
+var ErrNoPackageDefined = errors.New("no package clause in proto file")
 
 // Each type we import as a protocol buffer (other than FileDescriptorProto) needs
 // a pointer to the FileDescriptorProto that represents it.  These types achieve that
@@ -37,19 +38,19 @@ var ErrNoPackageDefined = errors.New("no package clause in proto file.")
 
 // The file and package name method are common to messages and enums.
 type common struct {
-	file *descriptor.FileDescriptorProto // File this object comes from.
+	file *FileDescriptor // File this object comes from.
 }
 
 // PackageName is name in the package clause in the generated file.
-func (c *common) PackageName() string { return uniquePackageOf(c.file) }
+func (c *common) PackageName() string { return c.file.PackageName() }
 
-func (c *common) File() *descriptor.FileDescriptorProto { return c.file }
+func (c *common) File() *descriptor.FileDescriptorProto { return c.file.FileDescriptorProto }
 
 func fileIsProto3(file *descriptor.FileDescriptorProto) bool {
 	return file.GetSyntax() == "proto3"
 }
 
-func (c *common) proto3() bool { return fileIsProto3(c.file) }
+func (c *common) proto3() bool { return c.file.proto3 }
 
 // Descriptor represents a protocol buffer message.
 type Descriptor struct {
@@ -131,8 +132,7 @@ func (e *EnumDescriptor) integerValueAsString(name string) string {
 			return fmt.Sprint(c.GetNumber())
 		}
 	}
-	log.Fatal("cannot find value for enum constant")
-	return ""
+	panic(&GenerationError{Msg: "cannot find value for enum constant " + name})
 }
 
 // ImportedDescriptor describes a type that has been publicly imported from another file.
@@ -154,14 +154,26 @@ type FileDescriptor struct {
 
 	// Comments, stored as a map of path (comma-separated integers) to the comment.
 	comments map[string]*descriptor.SourceCodeInfo_Location
+	// The source locations of all the elements, by path.
+	locations map[string]*descriptor.SourceCodeInfo_Location
 
 	index int // The index of this file in the list of files to generate code for
 
 	proto3 bool // whether to generate proto3 code for this file
+
+	packageName string // The unique package name of this file, underscored; set by SetPackageNames.
 }
 
 // PackageName is the package name we'll use in the generated code to refer to this file.
-func (d *FileDescriptor) PackageName() string { return uniquePackageOf(d.FileDescriptorProto) }
+// Each package name we generate must be unique. The package we're generating
+// gets its own name but every other package must have a unique name that does
+// not conflict in the code we generate.
+func (d *FileDescriptor) PackageName() string {
+	if d.packageName == "" {
+		panic(&GenerationError{Msg: "internal error: no package name defined for " + d.GetName()})
+	}
+	return d.packageName
+}
 
 // goPackageName returns the package name to use in the generated jspb file.
 func (d *FileDescriptor) goPackageName() (string, error) {
@@ -179,18 +191,6 @@ type Object interface {
 	File() *descriptor.FileDescriptorProto
 }
 
-// Each package name we generate must be unique. The package we're generating
-// gets its own name but every other package must have a unique name that does
-// not conflict in the code we generate.  These names are chosen globally (although
-// they don't have to be, it simplifies things to do them globally).
-func uniquePackageOf(fd *descriptor.FileDescriptorProto) string {
-	s, ok := uniquePackageName[fd]
-	if !ok {
-		log.Fatal("internal error: no package name defined for " + fd.GetName())
-	}
-	return s
-}
-
 // Generator is the type whose methods generate the output, stored in the associated response structure.
 type Generator struct {
 	*bytes.Buffer
@@ -198,8 +198,12 @@ type Generator struct {
 	Request  *plugin.CodeGeneratorRequest  // The input.
 	Response *plugin.CodeGeneratorResponse // The output.
 
-	Param     map[string]string // Command-line parameters.
-	PkgPrefix string            // String to prefix to imported package file names.
+	Param         map[string]string // Command-line parameters.
+	PkgPrefix     string            // String to prefix to imported package file names.
+	CanonicalJson bool              // Whether the JSON data follows the canonical proto3 JSON mapping.
+	Dts           bool              // Whether to generate TypeScript declarations next to the JavaScript code.
+	Target        string            // The kind of JavaScript output: targetClosure, targetESM or targetGoogModule.
+	Int64         string            // The representation of 64-bit integers: int64Number, int64String or int64Long.
 
 	Pkg map[string]string // The names under which we import support packages
 
@@ -208,40 +212,99 @@ type Generator struct {
 	allFilesByName   map[string]*FileDescriptor // All files by filename.
 	genFiles         []*FileDescriptor          // Those files we will generate output for.
 	file             *FileDescriptor            // The file we are compiling now.
-	usedPackages     map[string]bool            // Names of packages used in current file.
+	usedPackages     map[string]bool            // Namespaces used in current file, to be required.
+	path             string                     // The SourceCodeInfo path of the element being generated, to locate problems.
 	typeNameToObject map[string]Object          // Key is a fully-qualified name in input syntax.
+	pkgNamesInUse    map[string]bool            // Package names already registered, as they appear in the generated code.
 	init             []string                   // Lines to emit in the init function.
+	tsDecls          []*tsDecl                  // TypeScript declarations of the current file.
+	imports          map[*FileDescriptor]string // Aliases of the modules imported by the current file, by file.
+	helpers          map[string]bool            // Helper functions used in the current file, to be generated.
+	anyTypesProvider *FileDescriptor            // The Closure script providing registerAnyTypes, if any.
 	indent           string
 	writeOutput      bool
 }
 
+// The kinds of JavaScript output, selected by the target parameter.
+const (
+	targetClosure    = "closure"     // Closure scripts with goog.provide and goog.require.
+	targetESM        = "esm"         // ES modules with import and export.
+	targetGoogModule = "goog.module" // Closure modules with goog.module and goog.require.
+)
+
+// The JavaScript representations of 64-bit integers, selected by the int64
+// parameter and the jstype option of the fields.
+const (
+	int64Number = "number"         // Numbers, exact up to 2^53.
+	int64String = "string"         // Decimal strings.
+	int64Long   = "goog.math.Long" // goog.math.Long objects.
+)
+
 // New creates a new generator and allocates the request and response protobufs.
 func New() *Generator {
 	g := new(Generator)
 	g.Buffer = new(bytes.Buffer)
 	g.Request = new(plugin.CodeGeneratorRequest)
 	g.Response = new(plugin.CodeGeneratorResponse)
+	g.pkgNamesInUse = make(map[string]bool)
+	// Proto3 optional fields are handled like proto2 optional fields.
+	g.Response.SupportedFeatures = proto.Uint64(uint64(plugin.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL))
+	g.Target = targetClosure
+	g.Int64 = int64Number
 	return g
 }
 
-// Error reports a problem, including an error, and exits the program.
+// A GenerationError is a problem found while generating the code. The
+// exported methods of the Generator return it as their error.
+type GenerationError struct {
+	Pos string // The position in the .proto files, as file:line:column, or empty.
+	Msg string
+}
+
+func (e *GenerationError) Error() string {
+	if e.Pos == "" {
+		return e.Msg
+	}
+	return e.Pos + ": " + e.Msg
+}
+
+// Error reports a problem, including an error, and aborts the generation.
 func (g *Generator) Error(err error, msgs ...string) {
-	s := strings.Join(msgs, " ") + ":" + err.Error()
-	log.Print("protoc-gen-js: error:", s)
-	os.Exit(1)
+	g.Fail(strings.Join(msgs, " ") + ": " + err.Error())
 }
 
-// Fail reports a problem and exits the program.
+// Fail reports a problem and aborts the generation. The problem is located
+// at the element being generated, if any.
 func (g *Generator) Fail(msgs ...string) {
-	s := strings.Join(msgs, " ")
-	log.Print("protoc-gen-js: error:", s)
-	os.Exit(1)
+	pos := ""
+	if g.file != nil && g.path != "" {
+		pos = g.file.position(g.path)
+	}
+	g.failAt(pos, msgs...)
+}
+
+// failAt reports a problem at the position and aborts the generation.
+func (g *Generator) failAt(pos string, msgs ...string) {
+	panic(&GenerationError{Pos: pos, Msg: strings.Join(msgs, " ")})
+}
+
+// recoverError stops the panic of an aborted generation and stores the
+// reported problem in *err. Other panics are propagated.
+func recoverError(err *error) {
+	if r := recover(); r != nil {
+		e, ok := r.(*GenerationError)
+		if !ok {
+			panic(r)
+		}
+		*err = e
+	}
 }
 
 // CommandLineParameters breaks the comma-separated list of key=value pairs
 // in the parameter (a member of the request protobuf) into a key/value map.
 // It then sets file name mappings defined by those entries.
-func (g *Generator) CommandLineParameters(parameter string) {
+func (g *Generator) CommandLineParameters(parameter string) (err error) {
+	defer recoverError(&err)
 	g.Param = make(map[string]string)
 	for _, p := range strings.Split(parameter, ",") {
 		if i := strings.Index(p, "="); i < 0 {
@@ -255,8 +318,37 @@ func (g *Generator) CommandLineParameters(parameter string) {
 		switch k {
 		case "pkg_prefix":
 			g.PkgPrefix = v
+		case "json":
+			switch v {
+			case "canonical":
+				g.CanonicalJson = true
+			case "orig_name":
+				g.CanonicalJson = false
+			default:
+				g.Fail("unknown json mapping:", v)
+			}
+		case "dts":
+			g.Dts = v != "false"
+		case "target":
+			switch v {
+			case targetClosure, targetESM, targetGoogModule:
+				g.Target = v
+			default:
+				g.Fail("unknown target:", v)
+			}
+		case "int64":
+			switch v {
+			case int64Number, int64String, int64Long:
+				g.Int64 = v
+			default:
+				g.Fail("unknown int64 representation:", v)
+			}
 		}
 	}
+	if g.Int64 == int64Long && g.Target == targetESM {
+		g.Fail("int64=goog.math.Long requires the Closure library, not available to target=esm")
+	}
+	return nil
 }
 
 // DefaultPackageName returns the package name printed for the object.
@@ -270,28 +362,21 @@ func (g *Generator) DefaultPackageName(obj Object) string {
 	return pkg + "."
 }
 
-// For each input file, the unique package name to use, underscored.
-var uniquePackageName = make(map[*descriptor.FileDescriptorProto]string)
-
-// Package names already registered.  Key is the name from the .proto file;
-// value is the name that appears in the generated code.
-var pkgNamesInUse = make(map[string]bool)
-
 // Create and remember a guaranteed unique package name for this file descriptor.
 // Pkg is the candidate name.  If f is nil, it's a builtin package like "proto" and
 // has no file descriptor.
-func RegisterUniquePackageName(pkg string, f *FileDescriptor) string {
+func (g *Generator) RegisterUniquePackageName(pkg string, f *FileDescriptor) string {
 	// Convert dots to underscores before finding a unique alias.
 	pkg = strings.Map(badToUnderscore, pkg)
 
-	for i, orig := 1, pkg; pkgNamesInUse[pkg]; i++ {
+	for i, orig := 1, pkg; g.pkgNamesInUse[pkg]; i++ {
 		// It's a duplicate; must rename.
 		pkg = orig + strconv.Itoa(i)
 	}
 	// Install it.
-	pkgNamesInUse[pkg] = true
+	g.pkgNamesInUse[pkg] = true
 	if f != nil {
-		uniquePackageName[f.FileDescriptorProto] = pkg
+		f.packageName = pkg
 	}
 	return pkg
 }
@@ -316,31 +401,32 @@ var isJsKeyword = map[string]bool{
 // SetPackageNames sets the package name for this run.
 // The package name must agree across all files being generated.
 // It also defines unique package names for all imported files.
-func (g *Generator) SetPackageNames() {
+func (g *Generator) SetPackageNames() (err error) {
+	defer recoverError(&err)
 	pkg, err := g.genFiles[0].goPackageName()
 	if err != nil {
-		g.Error(err, g.genFiles[0].FileDescriptorProto.GetName())
+		g.failAt(g.genFiles[0].GetName(), err.Error())
 	}
 
 	// Check all files for an explicit go_package option.
 	for _, f := range g.genFiles {
 		thisPkg, err := f.goPackageName()
 		if err != nil {
-			g.Error(err, f.FileDescriptorProto.GetName())
+			g.failAt(f.GetName(), err.Error())
 		}
 		if thisPkg != pkg {
-			g.Fail("inconsistent package names:", thisPkg, pkg)
+			g.failAt(f.position(strconv.Itoa(packagePath)), "inconsistent package names:", thisPkg, pkg)
 		}
 	}
 
-	g.packageName = RegisterUniquePackageName(pkg, g.genFiles[0])
+	g.packageName = g.RegisterUniquePackageName(pkg, g.genFiles[0])
 
 AllFiles:
 	for _, f := range g.allFiles {
 		for _, genf := range g.genFiles {
 			if f == genf {
 				// In this package already.
-				uniquePackageName[f.FileDescriptorProto] = g.packageName
+				f.packageName = g.packageName
 				continue AllFiles
 			}
 		}
@@ -350,34 +436,34 @@ AllFiles:
 		if pkg == "" {
 			pkg = baseName(*f.Name)
 		}
-		RegisterUniquePackageName(pkg, f)
+		g.RegisterUniquePackageName(pkg, f)
 	}
+	return nil
 }
 
 // WrapTypes walks the incoming data, wrapping DescriptorProtos, EnumDescriptorProtos
 // and FileDescriptorProtos into file-referenced objects within the Generator.
 // It also creates the list of files to generate and so should be called before GenerateAllFiles.
-func (g *Generator) WrapTypes() {
+func (g *Generator) WrapTypes() (err error) {
+	defer recoverError(&err)
 	g.allFiles = make([]*FileDescriptor, len(g.Request.ProtoFile))
 	g.allFilesByName = make(map[string]*FileDescriptor, len(g.allFiles))
 	for i, f := range g.Request.ProtoFile {
-		// We must wrap the descriptors before we wrap the enums
-		descs := wrapDescriptors(f)
-		g.buildNestedDescriptors(descs)
-		enums := wrapEnumDescriptors(f, descs)
-		g.buildNestedEnums(descs, enums)
 		fd := &FileDescriptor{
 			FileDescriptorProto: f,
-			desc:                descs,
-			enum:                enums,
 			proto3:              fileIsProto3(f),
 		}
+		// We must wrap the descriptors before we wrap the enums
+		fd.desc = wrapDescriptors(fd)
+		g.buildNestedDescriptors(fd.desc)
+		fd.enum = wrapEnumDescriptors(fd, fd.desc)
+		g.buildNestedEnums(fd.desc, fd.enum)
 		extractComments(fd)
 		g.allFiles[i] = fd
 		g.allFilesByName[f.GetName()] = fd
 	}
 	for _, fd := range g.allFiles {
-		fd.imp = wrapImported(fd.FileDescriptorProto, g)
+		fd.imp = wrapImported(fd, g)
 	}
 
 	g.genFiles = make([]*FileDescriptor, len(g.Request.FileToGenerate))
@@ -389,6 +475,7 @@ func (g *Generator) WrapTypes() {
 		g.genFiles[i].index = i
 	}
 	g.Response.File = make([]*plugin.CodeGeneratorResponse_File, len(g.genFiles))
+	return nil
 }
 
 // Scan the descriptors in this file.  For each one, build the slice of nested descriptors
@@ -423,7 +510,7 @@ func (g *Generator) buildNestedEnums(descs []*Descriptor, enums []*EnumDescripto
 }
 
 // Construct the Descriptor
-func newDescriptor(desc *descriptor.DescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) *Descriptor {
+func newDescriptor(desc *descriptor.DescriptorProto, parent *Descriptor, file *FileDescriptor, index int) *Descriptor {
 	d := &Descriptor{
 		common:          common{file},
 		DescriptorProto: desc,
@@ -456,7 +543,7 @@ func newDescriptor(desc *descriptor.DescriptorProto, parent *Descriptor, file *d
 }
 
 // Return a slice of all the Descriptors defined within this file
-func wrapDescriptors(file *descriptor.FileDescriptorProto) []*Descriptor {
+func wrapDescriptors(file *FileDescriptor) []*Descriptor {
 	sl := make([]*Descriptor, 0, len(file.MessageType)+10)
 	for i, desc := range file.MessageType {
 		sl = wrapThisDescriptor(sl, desc, nil, file, i)
@@ -465,7 +552,7 @@ func wrapDescriptors(file *descriptor.FileDescriptorProto) []*Descriptor {
 }
 
 // Wrap this Descriptor, recursively
-func wrapThisDescriptor(sl []*Descriptor, desc *descriptor.DescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) []*Descriptor {
+func wrapThisDescriptor(sl []*Descriptor, desc *descriptor.DescriptorProto, parent *Descriptor, file *FileDescriptor, index int) []*Descriptor {
 	sl = append(sl, newDescriptor(desc, parent, file, index))
 	me := sl[len(sl)-1]
 	for i, nested := range desc.NestedType {
@@ -475,7 +562,7 @@ func wrapThisDescriptor(sl []*Descriptor, desc *descriptor.DescriptorProto, pare
 }
 
 // Construct the EnumDescriptor
-func newEnumDescriptor(desc *descriptor.EnumDescriptorProto, parent *Descriptor, file *descriptor.FileDescriptorProto, index int) *EnumDescriptor {
+func newEnumDescriptor(desc *descriptor.EnumDescriptorProto, parent *Descriptor, file *FileDescriptor, index int) *EnumDescriptor {
 	ed := &EnumDescriptor{
 		common:              common{file},
 		EnumDescriptorProto: desc,
@@ -491,7 +578,7 @@ func newEnumDescriptor(desc *descriptor.EnumDescriptorProto, parent *Descriptor,
 }
 
 // Return a slice of all the EnumDescriptors defined within this file
-func wrapEnumDescriptors(file *descriptor.FileDescriptorProto, descs []*Descriptor) []*EnumDescriptor {
+func wrapEnumDescriptors(file *FileDescriptor, descs []*Descriptor) []*EnumDescriptor {
 	sl := make([]*EnumDescriptor, 0, len(file.EnumType)+10)
 	// Top-level enums.
 	for i, enum := range file.EnumType {
@@ -507,7 +594,7 @@ func wrapEnumDescriptors(file *descriptor.FileDescriptorProto, descs []*Descript
 }
 
 // Return a slice of all the types that are publicly imported into this file.
-func wrapImported(file *descriptor.FileDescriptorProto, g *Generator) (sl []*ImportedDescriptor) {
+func wrapImported(file *FileDescriptor, g *Generator) (sl []*ImportedDescriptor) {
 	for _, index := range file.PublicDependency {
 		df := g.fileByName(file.Dependency[index])
 		for _, d := range df.desc {
@@ -525,22 +612,38 @@ func wrapImported(file *descriptor.FileDescriptorProto, g *Generator) (sl []*Imp
 
 func extractComments(file *FileDescriptor) {
 	file.comments = make(map[string]*descriptor.SourceCodeInfo_Location)
+	file.locations = make(map[string]*descriptor.SourceCodeInfo_Location)
 	for _, loc := range file.GetSourceCodeInfo().GetLocation() {
-		if loc.LeadingComments == nil {
-			continue
-		}
 		var p []string
 		for _, n := range loc.Path {
 			p = append(p, strconv.Itoa(int(n)))
 		}
+		if _, ok := file.locations[strings.Join(p, ",")]; !ok {
+			file.locations[strings.Join(p, ",")] = loc
+		}
+		if loc.LeadingComments == nil {
+			continue
+		}
 		file.comments[strings.Join(p, ",")] = loc
 	}
 }
 
+// position returns the position of the element at the path in the .proto
+// file, as file:line:column, or just the file name if it isn't known.
+func (d *FileDescriptor) position(path string) string {
+	loc, ok := d.locations[path]
+	if !ok || len(loc.Span) < 2 {
+		return d.GetName()
+	}
+	// The span is zero-based, positions are one-based.
+	return fmt.Sprintf("%s:%d:%d", d.GetName(), loc.Span[0]+1, loc.Span[1]+1)
+}
+
 // BuildTypeNameMap builds the map from fully qualified type names to objects.
 // The key names for the map come from the input data, which puts a period at the beginning.
 // It should be called after SetPackageNames and before GenerateAllFiles.
-func (g *Generator) BuildTypeNameMap() {
+func (g *Generator) BuildTypeNameMap() (err error) {
+	defer recoverError(&err)
 	g.typeNameToObject = make(map[string]Object)
 	for _, f := range g.allFiles {
 		// The names in this loop are defined by the proto world, not us, so the
@@ -551,19 +654,29 @@ func (g *Generator) BuildTypeNameMap() {
 			dottedPkg += "."
 		}
 		for _, enum := range f.enum {
-			name := dottedPkg + dottedSlice(enum.TypeName())
-			g.typeNameToObject[name] = enum
+			g.addTypeName(dottedPkg+dottedSlice(enum.TypeName()), enum, f.position(enum.path))
 		}
 		for _, desc := range f.desc {
-			name := dottedPkg + dottedSlice(desc.TypeName())
-			g.typeNameToObject[name] = desc
+			g.addTypeName(dottedPkg+dottedSlice(desc.TypeName()), desc, f.position(desc.path))
 		}
 	}
+	return nil
+}
+
+// addTypeName adds the object defined at the position to the map from fully
+// qualified type names to objects.
+func (g *Generator) addTypeName(name string, o Object, pos string) {
+	if prev, ok := g.typeNameToObject[name]; ok {
+		g.failAt(pos, strings.TrimPrefix(name, "."), "is already defined in", prev.File().GetName())
+	}
+	g.typeNameToObject[name] = o
 }
 
-// ObjectNamed, given a fully-qualified input type name as it appears in the input data,
-// returns the descriptor for the message or enum with that name.
-func (g *Generator) ObjectNamed(typeName string) Object {
+// objectNamed, given a fully-qualified input type name as it appears in the input data,
+// returns the descriptor for the message or enum with that name. The type must
+// be defined in the current file, in one of its dependencies or publicly
+// imported by one of them, as protoc checks.
+func (g *Generator) objectNamed(typeName string) Object {
 	o, ok := g.typeNameToObject[typeName]
 	if !ok {
 		g.Fail("can't find object with type", typeName)
@@ -597,7 +710,7 @@ func (g *Generator) ObjectNamed(typeName string) Object {
 			}
 		}
 		if !found {
-			log.Printf("protoc-gen-js: WARNING: failed finding publicly imported dependency for %v, used in %v", typeName, *g.file.Name)
+			g.Fail("can't find publicly imported dependency for", typeName)
 		}
 	}
 
@@ -654,8 +767,10 @@ func (g *Generator) Out() {
 	}
 }
 
-// GenerateAllFiles generates the output for all the files we're outputting.
-func (g *Generator) GenerateAllFiles() {
+// GenerateAllFiles generates the output for all the files to generate and
+// stores it in the response.
+func (g *Generator) GenerateAllFiles() (err error) {
+	defer recoverError(&err)
 	// Generate the output. The generator runs for every file, even the files
 	// that we don't generate output for, so that we can collate the full list
 	// of exported symbols to support public imports.
@@ -675,11 +790,18 @@ func (g *Generator) GenerateAllFiles() {
 		g.Response.File[i].Name = proto.String(jsFileName(*file.Name))
 		g.Response.File[i].Content = proto.String(g.String())
 		i++
+		if g.Dts {
+			g.Response.File = append(g.Response.File, &plugin.CodeGeneratorResponse_File{
+				Name:    proto.String(dtsFileName(*file.Name)),
+				Content: proto.String(g.generateDts()),
+			})
+		}
 	}
+	return nil
 }
 
-// FileOf return the FileDescriptor for this FileDescriptorProto.
-func (g *Generator) FileOf(fd *descriptor.FileDescriptorProto) *FileDescriptor {
+// fileOf returns the FileDescriptor for this FileDescriptorProto.
+func (g *Generator) fileOf(fd *descriptor.FileDescriptorProto) *FileDescriptor {
 	for _, file := range g.allFiles {
 		if file.FileDescriptorProto == fd {
 			return file
@@ -692,8 +814,12 @@ func (g *Generator) FileOf(fd *descriptor.FileDescriptorProto) *FileDescriptor {
 // Fill the response protocol buffer with the generated output for all the files we're
 // supposed to generate.
 func (g *Generator) generate(file *FileDescriptor) {
-	g.file = g.FileOf(file.FileDescriptorProto)
+	g.file = g.fileOf(file.FileDescriptorProto)
+	g.path = ""
 	g.usedPackages = make(map[string]bool)
+	g.tsDecls = nil
+	g.imports = make(map[*FileDescriptor]string)
+	g.helpers = make(map[string]bool)
 
 	for _, enum := range g.file.enum {
 		g.generateEnum(enum)
@@ -705,14 +831,33 @@ func (g *Generator) generate(file *FileDescriptor) {
 		}
 		g.generateMessage(desc)
 	}
+	g.generateExtensions()
+	for i, service := range g.file.Service {
+		g.generateService(service, i)
+	}
+	g.generateHelpers()
+	if g.helpers["anyType"] {
+		if g.Target == targetClosure && g.anyTypesProvider == nil && g.writeOutput {
+			g.anyTypesProvider = g.file
+		}
+		g.generateAnyTypes()
+	}
+
+	if g.Target == targetGoogModule {
+		g.generateExports()
+	}
 
 	// Generate header and imports last, though they appear first in the output.
 	rem := g.Buffer
 	g.Buffer = new(bytes.Buffer)
 	g.generateHeader()
-	g.generateProvides()
-	g.P()
-	g.generateRequires()
+	if g.Target == targetESM {
+		g.generateImports()
+	} else {
+		g.generateProvides()
+		g.P()
+		g.generateRequires()
+	}
 	g.P()
 	if !g.writeOutput {
 		return
@@ -754,6 +899,14 @@ func (g *Generator) PrintComments(path string) bool {
 	return false
 }
 
+// printDeprecated prints the @deprecated tag of the JSDoc of an element with
+// the deprecated option set, so the Closure Compiler warns about its uses.
+func (g *Generator) printDeprecated(deprecated bool) {
+	if deprecated {
+		g.P(" * @deprecated")
+	}
+}
+
 func (g *Generator) fileByName(filename string) *FileDescriptor {
 	return g.allFilesByName[filename]
 }
@@ -770,101 +923,198 @@ func (g *Generator) weak(i int32) bool {
 
 // Generate the provides.
 func (g *Generator) generateProvides() {
+	if g.Target == targetGoogModule {
+		g.P("goog.module('", g.moduleName(g.file), "');")
+		return
+	}
 	for _, enum := range g.file.enum {
-		// The full type name
-		typeName := enum.TypeName()
-		// The full type name, CamelCased.
-		ccTypeName := CamelCaseSlice(typeName)
-
-		if g.PkgPrefix != "" {
-			g.P("goog.provide('", g.PkgPrefix, ".", g.file.GetPackage(), ".", ccTypeName, "');")
-		} else {
-			g.P("goog.provide('", g.file.GetPackage(), ".", ccTypeName, "');")
-		}
+		g.P("goog.provide('", g.jsName(enum), "');")
+		g.P("goog.provide('", g.enumUtilName(enum), "');")
 	}
 	for _, desc := range g.file.desc {
 		// Don't generate virtual messages for maps.
 		if desc.GetOptions().GetMapEntry() {
 			continue
 		}
-
-		// The full type name
-		typeName := desc.TypeName()
-		// The full type name, CamelCased.
-		ccTypeName := CamelCaseSlice(typeName)
-
-		if g.PkgPrefix != "" {
-			g.P("goog.provide('", g.PkgPrefix, ".", g.file.GetPackage(), ".", ccTypeName, "');")
-		} else {
-			g.P("goog.provide('", g.file.GetPackage(), ".", ccTypeName, "');")
-		}
+		g.P("goog.provide('", g.jsName(desc), "');")
+	}
+	for _, field := range g.file.Extension {
+		g.P("goog.provide('", g.extensionName(nil, field), "');")
+	}
+	for _, service := range g.file.Service {
+		g.P("goog.provide('", g.clientName(service), "');")
+	}
+	if g.definesRegisterAnyTypes() {
+		g.P("goog.provide('", g.topLevelName("registerAnyTypes"), "');")
 	}
 }
 
-// Generate the requires.
+// Generate the requires: the support libraries used by the generated code,
+// and for Closure scripts the types of other files referenced by it, as
+// recorded in usedPackages. Each namespace is required once, in sorted order.
 func (g *Generator) generateRequires() {
-
-AllFiles:
-	for _, f := range g.allFiles {
-		for _, genf := range g.genFiles {
-			if f == genf {
-				continue AllFiles
-			}
+	var pkgs []string
+	for pkg := range g.usedPackages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	if g.Target == targetGoogModule {
+		for _, pkg := range pkgs {
+			g.P("const ", libAlias(pkg), " = goog.require('", pkg, "');")
 		}
-		// The file is a dependency, generate goog.requie().
-		pkg := f.GetPackage()
-		if pkg == "" {
-			pkg = baseName(*f.Name)
+		for _, f := range g.importedFiles() {
+			g.P("const ", g.imports[f], " = goog.require('", g.moduleName(f), "');")
 		}
+		return
+	}
+	for _, pkg := range pkgs {
+		g.P("goog.require('", pkg, "');")
+	}
+}
 
-		for _, enum := range f.enum {
-			// The full type name
-			typeName := enum.TypeName()
-			// The full type name, CamelCased.
-			ccTypeName := CamelCaseSlice(typeName)
+// Generate the imports of an ES module.
+func (g *Generator) generateImports() {
+	for pkg := range g.usedPackages {
+		if strings.HasPrefix(pkg, "jspb.") {
+			g.P("import * as jspb from 'google-protobuf';")
+			break
+		}
+	}
+	for _, f := range g.importedFiles() {
+		g.P("import * as ", g.imports[f], " from '", g.importPath(f), "';")
+	}
+	// Publicly imported files are re-exported.
+	for _, index := range g.file.PublicDependency {
+		g.P("export * from '", g.importPath(g.fileByName(g.file.Dependency[index])), "';")
+	}
+}
 
-			if g.PkgPrefix != "" {
-				g.P("goog.provide('", g.PkgPrefix, ".", f.GetPackage(), ".", ccTypeName, "');")
-			} else {
-				g.P("goog.provide('", f.GetPackage(), ".", ccTypeName, "');")
-			}
+// Generate the exports of a goog.module: the enums, messages, top-level
+// extensions and service clients defined in the file, and the types publicly
+// imported by the file.
+func (g *Generator) generateExports() {
+	var names []string
+	for _, enum := range g.file.enum {
+		names = append(names, g.jsName(enum), g.enumUtilName(enum))
+	}
+	for _, desc := range g.file.desc {
+		// Don't generate virtual messages for maps.
+		if desc.GetOptions().GetMapEntry() {
+			continue
 		}
-		for _, desc := range f.desc {
-			// Don't generate virtual messages for maps.
-			if desc.GetOptions().GetMapEntry() {
-				continue
-			}
+		names = append(names, g.jsName(desc))
+	}
+	for _, field := range g.file.Extension {
+		names = append(names, g.extensionName(nil, field))
+	}
+	for _, service := range g.file.Service {
+		names = append(names, g.clientName(service))
+	}
+	if g.definesRegisterAnyTypes() {
+		names = append(names, "registerAnyTypes")
+	}
+	for _, id := range g.file.imp {
+		names = append(names, CamelCaseSlice(id.TypeName())+": "+g.jsName(id))
+	}
+	g.P("exports = {")
+	g.In()
+	for i, name := range names {
+		g.P(name, trailingComma(i < len(names)-1))
+	}
+	g.Out()
+	g.P("};")
+}
 
-			// The full type name
-			typeName := desc.TypeName()
-			// The full type name, CamelCased.
-			ccTypeName := CamelCaseSlice(typeName)
+// moduleName returns the name of the goog.module generated for the file.
+func (g *Generator) moduleName(f *FileDescriptor) string {
+	name := strings.Map(badToUnderscore, baseName(f.GetName()))
+	if pkg := f.GetPackage(); pkg != "" {
+		name = pkg + "." + name
+	}
+	if g.PkgPrefix != "" {
+		name = g.PkgPrefix + "." + name
+	}
+	return name
+}
+
+// importedFiles returns the files imported by the current module, sorted by
+// their aliases.
+func (g *Generator) importedFiles() []*FileDescriptor {
+	var files []*FileDescriptor
+	for f := range g.imports {
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool { return g.imports[files[i]] < g.imports[files[j]] })
+	return files
+}
+
+// importAlias returns the alias of the module generated for the file, as
+// imported by the current file.
+func (g *Generator) importAlias(f *FileDescriptor) string {
+	if alias, ok := g.imports[f]; ok {
+		return alias
+	}
+	// The suffix keeps the aliases from conflicting with the support
+	// libraries and the names defined in the file.
+	base := strings.Map(badToUnderscore, strings.TrimSuffix(f.GetName(), path.Ext(f.GetName()))) + "_pb"
+	alias := base
+	for i := 1; g.aliasInUse(alias); i++ {
+		alias = base + strconv.Itoa(i)
+	}
+	g.imports[f] = alias
+	return alias
+}
 
-			g.P("goog.require('", g.PkgPrefix, ".", f.GetPackage(), ".", ccTypeName, "');")
+func (g *Generator) aliasInUse(alias string) bool {
+	for _, a := range g.imports {
+		if a == alias {
+			return true
 		}
-		g.P("goog.require('goog.array');")
 	}
+	return false
+}
+
+// importPath returns the path of the ES module generated for the file,
+// relative to the module of the current file.
+func (g *Generator) importPath(f *FileDescriptor) string {
+	from := strings.Split(path.Dir(g.file.GetName()), "/")
+	to := strings.Split(jsFileName(f.GetName()), "/")
+	if from[0] == "." {
+		from = from[:0]
+	}
+	for len(from) > 0 && len(to) > 1 && from[0] == to[0] {
+		from, to = from[1:], to[1:]
+	}
+	rel := strings.Join(to, "/")
+	if len(from) == 0 {
+		return "./" + rel
+	}
+	return strings.Repeat("../", len(from)) + rel
 }
 
 // Generate the enum definitions for this EnumDescriptor.
 func (g *Generator) generateEnum(enum *EnumDescriptor) {
-	// The full type name
-	typeName := enum.TypeName()
-	// The full type name, CamelCased.
-	ccTypeName := CamelCaseSlice(typeName)
-
+	g.path = enum.path
+	g.checkEnumUtilName(enum)
+	g.declareEnum(g.jsName(enum))
 	g.P("/**")
 	g.PrintComments(enum.path)
+	g.printDeprecated(enum.GetOptions().GetDeprecated())
 	g.P(" * @enum {number}")
 	g.P(" */")
-	if g.PkgPrefix != "" {
-		g.P(g.PkgPrefix, ".", g.file.GetPackage(), ".", ccTypeName, " = {")
-	} else {
-		g.P(g.file.GetPackage(), ".", ccTypeName, " = {")
-	}
+	g.P(g.defineName(enum), " = {")
 	n := len(enum.GetValue())
 	for i, v := range enum.GetValue() {
+		g.declare(g.jsName(enum), fmt.Sprintf("%s = %d,", v.GetName(), v.GetNumber()))
 		g.In()
+		// Only the commented and the deprecated values get a JSDoc.
+		path := fmt.Sprintf("%s,%d,%d", enum.path, enumValuePath, i)
+		if g.file.comments[path] != nil || v.GetOptions().GetDeprecated() {
+			g.P("/**")
+			g.PrintComments(path)
+			g.printDeprecated(v.GetOptions().GetDeprecated())
+			g.P(" */")
+		}
 		if i < n-1 {
 			g.P(fmt.Sprintf("%s: %d,", v.GetName(), v.GetNumber()))
 		} else {
@@ -874,6 +1124,156 @@ func (g *Generator) generateEnum(enum *EnumDescriptor) {
 	}
 	g.P("};")
 	g.P()
+	g.generateEnumUtil(enum)
+}
+
+// checkEnumUtilName fails if a type of the package has the name of the
+// helpers of the enum, the name of the enum with the Util suffix.
+func (g *Generator) checkEnumUtilName(enum *EnumDescriptor) {
+	name := dottedSlice(enum.TypeName()) + "Util"
+	if pkg := enum.File().GetPackage(); pkg != "" {
+		name = pkg + "." + name
+	}
+	if o, ok := g.typeNameToObject["."+name]; ok {
+		g.Fail("the name of the helpers of the enum", enum.GetName(), "is taken by", name, "in", o.File().GetName())
+	}
+}
+
+// enumUtilName returns the JavaScript name referring to the helpers of the
+// enum in the current file: the name of the enum with the Util suffix.
+func (g *Generator) enumUtilName(enum Object) string {
+	name := g.jsName(enum) + "Util"
+	if id, ok := enum.(*ImportedDescriptor); ok {
+		enum = id.o
+	}
+	if g.Target == targetClosure && enum.File() != g.file.FileDescriptorProto {
+		g.usedPackages[name] = true
+	}
+	return name
+}
+
+// generateEnumUtil generates the helpers of the enum: the names of its values
+// by value, the conversions between names and values and the list of values.
+// They are kept out of the enum so its keys remain the names of its values.
+func (g *Generator) generateEnumUtil(enum *EnumDescriptor) {
+	enumName, utilName := g.jsName(enum), g.enumUtilName(enum)
+	// The first name of a value wins over its aliases.
+	var values []int32
+	names := make(map[int32]string)
+	for _, v := range enum.GetValue() {
+		if _, ok := names[v.GetNumber()]; !ok {
+			values = append(values, v.GetNumber())
+			names[v.GetNumber()] = v.GetName()
+		}
+	}
+
+	tsEnum := enumName[strings.LastIndex(enumName, ".")+1:]
+	g.declareConst(utilName, "{readonly names: {readonly [value: number]: string}; nameOf(value: number): string | null; "+
+		"valueOf(name: string): "+tsEnum+" | null; values(): "+tsEnum+"[]; isValid(value: number): boolean}")
+
+	g.P("/**")
+	g.P(" * The helpers of the ", enumName, " enum.")
+	g.P(" * @const")
+	g.P(" */")
+	g.P(g.define(utilName), " = {")
+	g.In()
+	g.P("/**")
+	g.P(" * The names of the values, by value. Aliases have the name of the first")
+	g.P(" * value.")
+	g.P(" * @const {!Object.<number, string>}")
+	g.P(" */")
+	g.P("names: {")
+	g.In()
+	for i, v := range values {
+		key := strconv.Itoa(int(v))
+		if v < 0 {
+			key = "'" + key + "'"
+		}
+		g.P(key, ": '", names[v], "'", trailingComma(i < len(values)-1))
+	}
+	g.Out()
+	g.P("},")
+
+	g.P("/**")
+	g.P(" * @param {number} value The value.")
+	g.P(" * @return {?string} The name of the value, or null if the enum doesn't")
+	g.P(" *     define it.")
+	g.P(" */")
+	g.P("nameOf: function(value) {")
+	g.In()
+	g.P("return ", utilName, ".isValid(value) ? ", utilName, ".names[value] : null;")
+	g.Out()
+	g.P("},")
+
+	g.P("/**")
+	g.P(" * @param {string} name The name of a value.")
+	g.P(" * @return {?", enumName, "} The value, or null if the")
+	g.P(" *     enum doesn't define the name.")
+	g.P(" */")
+	g.P("valueOf: function(name) {")
+	g.In()
+	g.P("return Object.prototype.hasOwnProperty.call(", enumName, ", name) ? ", enumName, "[name] : null;")
+	g.Out()
+	g.P("},")
+
+	var list []string
+	for _, v := range values {
+		list = append(list, strconv.Itoa(int(v)))
+	}
+	g.P("/**")
+	g.P(" * @return {!Array.<", enumName, ">} The values, in the order of their")
+	g.P(" *     declaration, without the aliases.")
+	g.P(" */")
+	g.P("values: function() {")
+	g.In()
+	g.P("return [", strings.Join(list, ", "), "];")
+	g.Out()
+	g.P("},")
+
+	g.P("/**")
+	g.P(" * @param {number} value The value.")
+	g.P(" * @return {boolean} Whether the enum defines the value.")
+	g.P(" */")
+	g.P("isValid: function(value) {")
+	g.In()
+	g.P("return Object.prototype.hasOwnProperty.call(", utilName, ".names, value);")
+	g.Out()
+	g.P("}")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// enumDefault returns the JavaScript expression of the default of the enum
+// field: its explicit default, or the first value of the enum.
+func (g *Generator) enumDefault(field *descriptor.FieldDescriptorProto) string {
+	if field.DefaultValue != nil {
+		return g.defaultValue(field)
+	}
+	enum := g.enumNamed(field.GetTypeName())
+	return g.jsName(enum) + "." + enum.Value[0].GetName()
+}
+
+// The helper functions of the enums.
+var enumHelpers = map[string]jsHelper{
+	"enumFromJSON": {
+		doc: `Converts an enum value from its canonical JSON form, the name of the value
+or its number, maybe as a decimal string, into its number.
+@param {{valueOf: function(string): ?number}} util The helpers of the enum.
+@param {*} v The JSON value.
+@param {number} def The default of the field, for the names the enum doesn't
+    define.
+@return {number} The number.`,
+		params: "util, v, def",
+		body: `if (typeof v !== 'string') {
+	return /** @type {number} */ (v);
+}
+if (/^-?[0-9]+$/.test(v)) {
+	return Number(v);
+}
+var n = util.valueOf(v);
+return n !== null ? n : def;`,
+	},
 }
 
 // TypeName is the printed name appropriate for an item. If the object is in the current file,
@@ -891,6 +1291,375 @@ func (g *Generator) TypeNameWithPackage(obj Object) string {
 	return obj.PackageName() + CamelCaseSlice(obj.TypeName())
 }
 
+// jsName returns the JavaScript name referring to the object in the current
+// file. For Closure scripts it's the fully qualified name declared by
+// goog.provide, including the package prefix. For modules it's the name
+// exported by the module, qualified by the import alias of the module if the
+// object is defined in another file.
+func (g *Generator) jsName(obj Object) string {
+	if id, ok := obj.(*ImportedDescriptor); ok {
+		obj = id.o
+	}
+	name := CamelCaseSlice(obj.TypeName())
+	if g.Target != targetClosure {
+		if obj.File() == g.file.FileDescriptorProto {
+			return name
+		}
+		return g.importAlias(g.fileByName(obj.File().GetName())) + "." + name
+	}
+	if pkg := obj.File().GetPackage(); pkg != "" {
+		name = pkg + "." + name
+	}
+	if g.PkgPrefix != "" {
+		name = g.PkgPrefix + "." + name
+	}
+	if obj.File() != g.file.FileDescriptorProto {
+		// Every type is provided by its own name, so it's required as is.
+		g.usedPackages[name] = true
+	}
+	return name
+}
+
+// defineName returns the left-hand side of the statement defining the class
+// or enum generated for the object in the current file.
+func (g *Generator) defineName(obj Object) string {
+	return g.define(g.jsName(obj))
+}
+
+// define returns the left-hand side of the statement defining the generated
+// object of the given JavaScript name in the current file.
+func (g *Generator) define(name string) string {
+	switch g.Target {
+	case targetESM:
+		return "export const " + name
+	case targetGoogModule:
+		return "const " + name
+	}
+	return name
+}
+
+// topLevelName returns the JavaScript name of an object defined at the top
+// level of the current file, outside of the classes: for Closure scripts the
+// name qualified by the package, which goog.provide declares.
+func (g *Generator) topLevelName(name string) string {
+	if g.Target != targetClosure {
+		return name
+	}
+	if pkg := g.file.GetPackage(); pkg != "" {
+		name = pkg + "." + name
+	}
+	if g.PkgPrefix != "" {
+		name = g.PkgPrefix + "." + name
+	}
+	return name
+}
+
+// use returns the expression referring to a namespace of a support library,
+// such as jspb.BinaryReader, and records the use so the namespace is required
+// by the current file. A goog.module refers to the namespace by a local alias.
+func (g *Generator) use(ns string) string {
+	g.usedPackages[ns] = true
+	if g.Target == targetGoogModule {
+		return libAlias(ns)
+	}
+	return ns
+}
+
+// libAlias returns the local alias of a support library namespace in a
+// goog.module, the lowerCamelCase concatenation of its parts. The aliases
+// can't conflict with the UpperCamelCase names of the generated types.
+func libAlias(ns string) string {
+	parts := strings.Split(ns, ".")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// forEach returns the first line of a loop calling a function with the given
+// parameters for each element of an array. The loop ends with "}, this);".
+func (g *Generator) forEach(array, params string) string {
+	if g.Target == targetESM {
+		return array + ".forEach(function(" + params + ") {"
+	}
+	return g.use("goog.array") + ".forEach(" + array + ", function(" + params + ") {"
+}
+
+// int64Type returns the representation of the 64-bit integer field,
+// int64Number, int64String or int64Long, or "" if the field isn't a 64-bit
+// integer. The jstype option of the field overrides the int64 parameter.
+func (g *Generator) int64Type(field *descriptor.FieldDescriptorProto) string {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+	default:
+		return ""
+	}
+	switch field.GetOptions().GetJstype() {
+	case descriptor.FieldOptions_JS_STRING:
+		return int64String
+	case descriptor.FieldOptions_JS_NUMBER:
+		return int64Number
+	}
+	return g.Int64
+}
+
+// int64JsType returns the JavaScript type of the values of the 64-bit
+// integer field.
+func (g *Generator) int64JsType(field *descriptor.FieldDescriptorProto) string {
+	if g.int64Type(field) == int64Long {
+		return g.use("goog.math.Long")
+	}
+	return g.int64Type(field)
+}
+
+// int64AsString reports whether the values of the 64-bit integer field are
+// kept as decimal strings in the JSON data even outside canonical JSON mode,
+// so no precision is lost.
+func (g *Generator) int64AsString(field *descriptor.FieldDescriptorProto) bool {
+	t := g.int64Type(field)
+	return t == int64String || t == int64Long
+}
+
+// defaultValue returns the JavaScript literal of the explicit default value
+// of the field, as declared in the .proto file.
+func (g *Generator) defaultValue(field *descriptor.FieldDescriptorProto) string {
+	def := field.GetDefaultValue()
+	switch g.int64Type(field) {
+	case int64String:
+		return jsString(def)
+	case int64Long:
+		return g.use("goog.math.Long") + ".fromString(" + jsString(def) + ")"
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return jsString(def)
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		// Bytes are C-escaped in the descriptor, and base64 strings in the
+		// JSON data.
+		b, err := unescapeC(def)
+		if err != nil {
+			g.Error(err, "bad default value for", field.GetName())
+		}
+		return jsString(base64.StdEncoding.EncodeToString(b))
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		switch def {
+		case "inf":
+			return "Infinity"
+		case "-inf":
+			return "-Infinity"
+		case "nan":
+			return "NaN"
+		}
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		// The default is the name of the value.
+		enum := g.enumNamed(field.GetTypeName())
+		for _, v := range enum.Value {
+			if v.GetName() == def {
+				return g.jsName(enum) + "." + def
+			}
+		}
+		g.Fail("unknown default value", def, "for", field.GetName())
+	}
+	// Numbers and booleans are the same in JavaScript.
+	return def
+}
+
+// jsString returns the JavaScript string literal of s. Unlike strconv.Quote,
+// whose escapes are Go syntax, it only uses the escapes JavaScript reads the
+// same way, and it escapes the line and paragraph separators, which end the
+// line in older JavaScript engines.
+func jsString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\u2028' || r == '\u2029' || !unicode.IsPrint(r):
+			for _, u := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&b, `\u%04x`, u)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// unescapeC returns the bytes of a string escaped with C escape sequences,
+// as protoc escapes the default values of bytes fields.
+func unescapeC(s string) ([]byte, error) {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b = append(b, s[i])
+			continue
+		}
+		i++
+		if i == len(s) {
+			return nil, errors.New("trailing backslash")
+		}
+		switch c := s[i]; c {
+		case 'a':
+			b = append(b, '\a')
+		case 'b':
+			b = append(b, '\b')
+		case 'f':
+			b = append(b, '\f')
+		case 'n':
+			b = append(b, '\n')
+		case 'r':
+			b = append(b, '\r')
+		case 't':
+			b = append(b, '\t')
+		case 'v':
+			b = append(b, '\v')
+		case '\\', '\'', '"', '?':
+			b = append(b, c)
+		case 'x', 'X':
+			// One or two hex digits.
+			j := i + 1
+			for j < len(s) && j < i+3 && strings.IndexByte("0123456789abcdefABCDEF", s[j]) >= 0 {
+				j++
+			}
+			n, err := strconv.ParseUint(s[i+1:j], 16, 8)
+			if err != nil {
+				return nil, fmt.Errorf("bad hex escape in %q", s)
+			}
+			b = append(b, byte(n))
+			i = j - 1
+		default:
+			// One to three octal digits.
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			n, err := strconv.ParseUint(s[i:j], 8, 8)
+			if err != nil {
+				return nil, fmt.Errorf("bad escape in %q", s)
+			}
+			b = append(b, byte(n))
+			i = j - 1
+		}
+	}
+	return b, nil
+}
+
+// enumNamed returns the descriptor of the enum with the fully-qualified name.
+func (g *Generator) enumNamed(typeName string) *EnumDescriptor {
+	obj := g.objectNamed(typeName)
+	if id, ok := obj.(*ImportedDescriptor); ok {
+		obj = id.o
+	}
+	enum, ok := obj.(*EnumDescriptor)
+	if !ok {
+		g.Fail(typeName, "is not an enum")
+	}
+	return enum
+}
+
+// jsonKey returns the name of the field in the JSON data: the original proto
+// field name, or its lowerCamelCase json_name in canonical JSON mode.
+func (g *Generator) jsonKey(field *descriptor.FieldDescriptorProto) string {
+	if !g.CanonicalJson {
+		return field.GetName()
+	}
+	if field.JsonName != nil {
+		return field.GetJsonName()
+	}
+	return jsonCamelCase(field.GetName())
+}
+
+// fromJSON returns the JavaScript expression converting expr, a single value
+// of the field as stored in the JSON data, into the JavaScript type of the
+// field. It returns expr itself if no conversion is needed, which is always
+// the case unless in canonical JSON mode or for 64-bit integers not
+// represented by numbers. The expr may be evaluated more than once.
+func (g *Generator) fromJSON(field *descriptor.FieldDescriptorProto, expr string) string {
+	if wellKnownType(field) != "" {
+		return g.wktFromJSONExpr(field, expr)
+	}
+	switch g.int64Type(field) {
+	case int64String:
+		return "String(" + expr + ")"
+	case int64Long:
+		return g.use("goog.math.Long") + ".fromString(String(" + expr + "))"
+	}
+	if !g.CanonicalJson {
+		return expr
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_INT32,
+		descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32,
+		descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		// 64-bit integers are strings, and the other integers may be;
+		// NaN and the infinities of floating point numbers are "NaN",
+		// "Infinity" and "-Infinity".
+		return "Number(" + expr + ")"
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		// Enums are the names of the values, or the numbers of unknown
+		// values. The names unknown to the enum, maybe added to a newer
+		// version of the .proto file, are the default.
+		util := g.enumUtilName(g.objectNamed(field.GetTypeName()))
+		return fmt.Sprintf("%s(%s, %s, %s)", g.helper("enumFromJSON"), util, expr, g.enumDefault(field))
+	}
+	return expr
+}
+
+// toJSON is the reverse of fromJSON: it returns the JavaScript expression
+// converting expr, a single value of the field, into its JSON form.
+func (g *Generator) toJSON(field *descriptor.FieldDescriptorProto, expr string) string {
+	if wellKnownType(field) != "" {
+		return g.wktToJSONExpr(field, expr)
+	}
+	switch g.int64Type(field) {
+	case int64String:
+		return expr
+	case int64Long:
+		return expr + ".toString()"
+	}
+	if !g.CanonicalJson {
+		return expr
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64:
+		return "String(" + expr + ")"
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		return fmt.Sprintf("(isFinite(%s) ? %s : String(%s))", expr, expr, expr)
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		util := g.enumUtilName(g.objectNamed(field.GetTypeName()))
+		return fmt.Sprintf("(%s.nameOf(%s) || %s)", util, expr, expr)
+	}
+	return expr
+}
+
 // JsType returns a string representing the type name, element type (empty
 // if it's not an array of messages), and the wire type.
 func (g *Generator) JsType(message *Descriptor, field *descriptor.FieldDescriptorProto) (typ, eleTyp string, wire string) {
@@ -901,15 +1670,15 @@ func (g *Generator) JsType(message *Descriptor, field *descriptor.FieldDescripto
 	case descriptor.FieldDescriptorProto_TYPE_FLOAT:
 		typ, wire = "number", "fixed32"
 	case descriptor.FieldDescriptorProto_TYPE_INT64:
-		typ, wire = "number", "varint"
+		typ, wire = g.int64JsType(field), "varint"
 	case descriptor.FieldDescriptorProto_TYPE_UINT64:
-		typ, wire = "number", "varint"
+		typ, wire = g.int64JsType(field), "varint"
 	case descriptor.FieldDescriptorProto_TYPE_INT32:
 		typ, wire = "number", "varint"
 	case descriptor.FieldDescriptorProto_TYPE_UINT32:
 		typ, wire = "number", "varint"
 	case descriptor.FieldDescriptorProto_TYPE_FIXED64:
-		typ, wire = "number", "fixed64"
+		typ, wire = g.int64JsType(field), "fixed64"
 	case descriptor.FieldDescriptorProto_TYPE_FIXED32:
 		typ, wire = "number", "fixed32"
 	case descriptor.FieldDescriptorProto_TYPE_BOOL:
@@ -917,82 +1686,99 @@ func (g *Generator) JsType(message *Descriptor, field *descriptor.FieldDescripto
 	case descriptor.FieldDescriptorProto_TYPE_STRING:
 		typ, wire = "string", "bytes"
 	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
-		desc := g.ObjectNamed(field.GetTypeName())
-		typ, wire = g.TypeName(desc), "bytes"
+		if wellKnownType(field) != "" {
+			typ, wire = g.wktJsType(field), "bytes"
+			if !isRepeated(field) && typ != "*" {
+				// Unset fields of well-known types are null.
+				typ = "?" + typ
+			}
+			break
+		}
+		desc := g.objectNamed(field.GetTypeName())
+		typ, wire = g.jsName(desc), "bytes"
 	case descriptor.FieldDescriptorProto_TYPE_BYTES:
 		typ, wire = "string", "bytes"
 	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		desc := g.ObjectNamed(field.GetTypeName())
-		typ, wire = g.TypeName(desc), "varint"
+		desc := g.objectNamed(field.GetTypeName())
+		typ, wire = g.jsName(desc), "varint"
 	case descriptor.FieldDescriptorProto_TYPE_SFIXED32:
 		typ, wire = "number", "fixed32"
 	case descriptor.FieldDescriptorProto_TYPE_SFIXED64:
-		typ, wire = "number", "fixed64"
+		typ, wire = g.int64JsType(field), "fixed64"
 	case descriptor.FieldDescriptorProto_TYPE_SINT32:
 		typ, wire = "number", "zigzag32"
 	case descriptor.FieldDescriptorProto_TYPE_SINT64:
-		typ, wire = "number", "zigzag64"
+		typ, wire = g.int64JsType(field), "zigzag64"
 	default:
 		g.Fail("unknown type for", field.GetName())
 	}
 	if isRepeated(field) {
-		if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
-			eleTyp = g.PkgPrefix + "." + message.PackageName() + "." + typ
+		if isMessage(field) {
+			eleTyp = typ
 			typ = "Array.<" + eleTyp + ">"
 		} else if *field.Type == descriptor.FieldDescriptorProto_TYPE_ENUM {
 			typ = "Array.<number>"
 		} else {
 			typ = "Array.<" + typ + ">"
 		}
-	} else if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE ||
-		*field.Type == descriptor.FieldDescriptorProto_TYPE_ENUM {
-		typ = g.PkgPrefix + "." + message.PackageName() + "." + typ
 	}
 	return
 }
 
-// Method names that may be generated.  Fields with these names get an
-// underscore appended.
+// The methods generated for every message. The accessors of the fields with
+// the same names get an underscore appended, and so does hasOwnProperty, used
+// on objects by many libraries.
 var methodNames = [...]string{
-	"Reset",
-	"String",
-	"ProtoMessage",
-	"Marshal",
-	"Unmarshal",
-	"ExtensionRangeArray",
-	"ExtensionMap",
-	"Descriptor",
+	"getJsonData",
+	"serializeBinary",
+	"equals",
+	"deepCopy",
+	"clone",
+	"mergeFrom",
+	"applyFieldMask",
+	"diffFieldMask",
+	"validate",
+	"hasOwnProperty",
 }
 
 // Generate the type and default constant definitions for this Descriptor.
 func (g *Generator) generateMessage(message *Descriptor) {
-	// The full type name
-	typeName := message.TypeName()
-
-	// The full type name, CamelCased.
-	ccTypeName := CamelCaseSlice(typeName)
+	g.path = message.path
+	// The fully qualified name of the generated class.
+	className := g.jsName(message)
 
 	usedNames := make(map[string]bool)
+	for _, name := range methodNames {
+		usedNames[name] = true
+	}
 	fieldNames := make(map[*descriptor.FieldDescriptorProto]string)
 	fieldGetterNames := make(map[*descriptor.FieldDescriptorProto]string)
+	fieldHasNames := make(map[*descriptor.FieldDescriptorProto]string)
 	fieldTypes := make(map[*descriptor.FieldDescriptorProto]string)
 	mapFieldTypes := make(map[*descriptor.FieldDescriptorProto]string)
 
 	oneofFieldName := make(map[int32]string)                           // indexed by oneof_index field of FieldDescriptorProto
-	oneofDisc := make(map[int32]string)                                // name of discriminator method
-	oneofTypeName := make(map[*descriptor.FieldDescriptorProto]string) // without star
-	oneofInsertPoints := make(map[int32]int)                           // oneof_index => offset of g.Buffer
+	oneofDisc := make(map[int32]string)                                // name of the case getter
+	oneofClear := make(map[int32]string)                               // name of the clear method
+	oneofTypeName := make(map[*descriptor.FieldDescriptorProto]string) // name of the case enum constant
+
+	if isExtendable(message) {
+		for _, name := range extensionMethods {
+			usedNames[name] = true
+		}
+	}
+
+	g.declareClass(className)
+	g.declare(className, "constructor(jsonData: Object);")
+	g.declare(className, "getJsonData(): Object;")
 
 	g.P("/**")
 	g.PrintComments(message.path)
+	g.printDeprecated(message.GetOptions().GetDeprecated())
 	g.P(" * @param {Object} jsonData The JSON data.")
 	g.P(" * @constructor")
 	g.P(" */")
-	if g.PkgPrefix != "" {
-		g.P(g.PkgPrefix, ".", message.PackageName(), ".", ccTypeName, " = function(jsonData) {")
-	} else {
-		g.P(message.PackageName(), ".", ccTypeName, " = function(jsonData) {")
-	}
+	g.P(g.defineName(message), " = function(jsonData) {")
 	g.In()
 	g.P("/**")
 	g.P(" * @private {Object}")
@@ -1006,43 +1792,48 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	g.P("/**")
 	g.P(" * @return {Object} The JSON data.")
 	g.P(" */")
-	if g.PkgPrefix != "" {
-		g.P(g.PkgPrefix, ".", message.PackageName(), ".", ccTypeName, ".prototype.getJsonData", " = function() {")
-	} else {
-		g.P(message.PackageName(), ".", ccTypeName, ".getJsonData = function() {")
-	}
+	g.P(className, ".prototype.getJsonData = function() {")
 	g.In()
 	g.P("return this.jsonData_;")
 	g.Out()
 	g.P("};")
 	g.P()
 
+	// The full name identifies the type in google.protobuf.Any, whose
+	// unpacking finds the class among the registered ones by it.
+	fullName := dottedSlice(message.TypeName())
+	if pkg := message.File().GetPackage(); pkg != "" {
+		fullName = pkg + "." + fullName
+	}
+	g.declare(className, "static readonly TYPE_NAME: string;")
+	g.P("/**")
+	g.P(" * The full name of the message type.")
+	g.P(" * @const {string}")
+	g.P(" */")
+	g.P(className, ".TYPE_NAME = '", fullName, "';")
+	g.P()
+
 	// Default constants
 	defNames := make(map[*descriptor.FieldDescriptorProto]string)
-	for _, field := range message.Field {
+	for i, field := range message.Field {
+		g.path = fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i)
 		typename, _, _ := g.JsType(message, field)
 
-		def := field.GetDefaultValue()
-		if def != "" {
-			defNames[field] = strconv.Quote(def)
+		if field.DefaultValue != nil {
+			// Explicit defaults are exposed as static constants.
+			constName := "DEFAULT_" + strings.ToUpper(field.GetName())
+			g.declare(className, fmt.Sprintf("static readonly %s: %s;", constName, tsType(typename)))
+			g.P("/**")
+			g.P(" * The default value of the ", field.GetName(), " field.")
+			g.P(" * @const {", typename, "}")
+			g.P(" */")
+			g.P(className, ".", constName, " = ", g.defaultValue(field), ";")
+			g.P()
+			defNames[field] = className + "." + constName
 			continue
 		}
 
-		switch {
-		case typename == "boolean":
-			def = "false"
-		case typename == "string":
-			def = "''"
-		case typename == "number":
-			def = "0"
-		case strings.HasPrefix(typename, "Array."):
-			def = "[]"
-		case *field.Type == descriptor.FieldDescriptorProto_TYPE_ENUM:
-			def = "0"
-		default:
-			def = "undefined"
-		}
-		defNames[field] = def
+		defNames[field] = g.zeroValue(field, typename, message.proto3())
 	}
 
 	// allocNames finds a conflict-free variation of the given strings,
@@ -1067,6 +1858,7 @@ func (g *Generator) generateMessage(message *Descriptor) {
 	}
 
 	for i, field := range message.Field {
+		g.path = fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i)
 		// Allocate the getter and the field at the same time so name
 		// collisions create field/method consistent names.
 		// TODO: This allocation occurs based on the order of the fields
@@ -1080,109 +1872,70 @@ func (g *Generator) generateMessage(message *Descriptor) {
 		fieldNames[field] = fieldName
 		fieldGetterNames[field] = fieldGetterName
 
-		oneof := field.OneofIndex != nil
+		oneof := inRealOneof(field)
 		if oneof && oneofFieldName[*field.OneofIndex] == "" {
-			odp := message.OneofDecl[int(*field.OneofIndex)]
-			fname := allocNames(CamelCase(odp.GetName()))[0]
-
 			// This is the first field of a oneof we haven't seen before.
-			// Generate the union field.
-			com := g.PrintComments(fmt.Sprintf("%s,%d,%d", message.path, messageOneofPath, *field.OneofIndex))
-			if com {
-				g.P("//")
-			}
-			g.P("// Types that are valid to be assigned to ", fname, ":")
-			// Generate the rest of this comment later,
-			// when we've computed any disambiguation.
-			oneofInsertPoints[*field.OneofIndex] = g.Buffer.Len()
-
-			dname := "is" + ccTypeName + "_" + fname
-			oneofFieldName[*field.OneofIndex] = fname
-			oneofDisc[*field.OneofIndex] = dname
-			tag := `protobuf_oneof:"` + odp.GetName() + `"`
-			g.P(fname, " ", dname, " `", tag, "`")
-		}
-
-		if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
-			desc := g.ObjectNamed(field.GetTypeName())
-			if d, ok := desc.(*Descriptor); ok && d.GetOptions().GetMapEntry() {
-				// Figure out the Go types and tags for the key and value types.
-				keyField, valField := d.Field[0], d.Field[1]
-				keyType, _, _ := g.JsType(d, keyField)
-				valType, _, _ := g.JsType(d, valField)
-
-				// We don't use stars, except for message-typed values.
-				// Message and enum types are the only two possibly foreign types used in maps,
-				// so record their use. They are not permitted as map keys.
-				keyType = strings.TrimPrefix(keyType, "*")
-				switch *valField.Type {
-				case descriptor.FieldDescriptorProto_TYPE_ENUM:
-					valType = strings.TrimPrefix(valType, "*")
-				default:
-					valType = strings.TrimPrefix(valType, "*")
-				}
+			// Allocate the names of the case getter and the clear method.
+			odp := message.OneofDecl[int(*field.OneofIndex)]
+			ns := allocNames(CamelCase(odp.GetName()), "get"+CamelCase(odp.GetName())+"Case", "clear"+CamelCase(odp.GetName()))
+			oneofFieldName[*field.OneofIndex] = ns[0]
+			oneofDisc[*field.OneofIndex] = ns[1]
+			oneofClear[*field.OneofIndex] = ns[2]
+		}
 
-				typename = fmt.Sprintf("map[%s]%s", keyType, valType)
-				mapFieldTypes[field] = typename // record for the getter generation
-			}
+		mapEntry := g.mapEntry(field)
+		if mapEntry != nil {
+			// Figure out the JavaScript types for the key and value types.
+			// Maps are stored as JSON objects, whose keys are always strings.
+			keyField, valField := mapEntry.Field[0], mapEntry.Field[1]
+			keyType, _, _ := g.JsType(mapEntry, keyField)
+			valType, _, _ := g.JsType(mapEntry, valField)
+			// Map values are never null, even of well-known types.
+			valType = strings.TrimPrefix(valType, "?")
+
+			typename = fmt.Sprintf("Object.<string, %s>", valType)
+			mapFieldTypes[field] = fmt.Sprintf("Map.<%s, %s>", keyType, valType) // record for the getter generation
 		}
 
 		fieldTypes[field] = typename
 
 		if oneof {
-			tname := ccTypeName + "_" + fieldName
-			// It is possible for this to collide with a message or enum
-			// nested in this message. Check for collisions.
-			for {
-				ok := true
-				for _, desc := range message.nested {
-					if CamelCaseSlice(desc.TypeName()) == tname {
-						ok = false
-						break
-					}
-				}
-				for _, enum := range message.enums {
-					if CamelCaseSlice(enum.TypeName()) == tname {
-						ok = false
-						break
-					}
-				}
-				if !ok {
-					tname += "_"
-					continue
-				}
-				break
-			}
+			// The case constant of the field in the oneof's case enum.
+			oneofTypeName[field] = strings.ToUpper(field.GetName())
+		}
 
-			oneofTypeName[field] = tname
+		if mapEntry != nil {
+			ms := allocNames("get"+base+"Map", "set"+base+"Map")
+			g.generateMapField(className, fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i), field, mapEntry,
+				typename, mapFieldTypes[field], fieldGetterName, fieldSetterName, ms[0], ms[1])
 			continue
 		}
 
+		g.declare(className, fmt.Sprintf("%s(): %s;", fieldGetterName, tsType(typename)))
+		g.declare(className, fmt.Sprintf("%s(%s: %s): void;", fieldSetterName, field.GetName(), tsType(typename)))
+
 		// Generate getters.
 
 		g.P("/**")
 		g.PrintComments(fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i))
+		g.printDeprecated(field.GetOptions().GetDeprecated())
 		g.P(" * @return {", typename, "}")
 		g.P(" */")
-		if g.PkgPrefix != "" {
-			g.P(g.PkgPrefix, ".", message.PackageName(), ".", ccTypeName, ".prototype.", fieldGetterName, " = function() {")
-		} else {
-			g.P(message.PackageName(), ".", ccTypeName, ".prototype.", fieldGetterName, " = function() {")
-		}
+		g.P(className, ".prototype.", fieldGetterName, " = function() {")
 		g.In()
-		if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		if isMessage(field) {
 			g.P(fmt.Sprintf("if (this.%s_) {", field.GetName()))
 			g.In()
 			g.P(fmt.Sprintf("return this.%s_;", field.GetName()))
 			g.Out()
 			g.P("}")
-			g.P("var v = this.jsonData_[\"" + field.GetName() + "\"];")
+			g.P("var v = this.jsonData_[\"" + g.jsonKey(field) + "\"];")
 			g.P("if (v) {")
 			g.In()
 			if eleTyp != "" {
 				g.P(fmt.Sprintf("/** @private {%s} */", typename))
 				g.P(fmt.Sprintf("this.%s_ = [];", field.GetName()))
-				g.P("goog.array.forEach(v, function(__item, __index) {")
+				g.P(g.forEach("v", "__item, __index"))
 				g.In()
 				g.P(fmt.Sprintf("this.%s_.push(new %s(__item));", field.GetName(), eleTyp))
 				g.Out()
@@ -1196,8 +1949,19 @@ func (g *Generator) generateMessage(message *Descriptor) {
 			g.Out()
 			g.P("}")
 			g.P("return " + defNames[field] + ";")
+		} else if isRepeated(field) && g.fromJSON(field, "__v") != "__v" {
+			g.P(fmt.Sprintf("return (this.jsonData_[\"%s\"] || []).map(function(__v) {", g.jsonKey(field)))
+			g.In()
+			g.P("return ", g.fromJSON(field, "__v"), ";")
+			g.Out()
+			g.P("});")
+		} else if isRepeated(field) {
+			g.P(fmt.Sprintf("return this.jsonData_[\"%s\"] || ", g.jsonKey(field)), defNames[field], ";")
 		} else {
-			g.P(fmt.Sprintf("return this.jsonData_[\"%s\"] || ", field.GetName()), defNames[field], ";")
+			// Only an absent value is replaced by the default, stored values
+			// like false, 0 or '' are returned as is.
+			g.P(fmt.Sprintf("var v = this.jsonData_[\"%s\"];", g.jsonKey(field)))
+			g.P("return v != null ? ", g.fromJSON(field, "v"), " : ", defNames[field], ";")
 		}
 		g.Out()
 		g.P("};")
@@ -1207,101 +1971,397 @@ func (g *Generator) generateMessage(message *Descriptor) {
 
 		g.P("/**")
 		g.PrintComments(fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i))
+		g.printDeprecated(field.GetOptions().GetDeprecated())
 		g.P(" * @param {", typename, "} ", field.GetName(), " The ", field.GetName()+".")
 		g.P(" */")
-		if g.PkgPrefix != "" {
-			g.P(g.PkgPrefix, ".", message.PackageName(), ".", ccTypeName, ".prototype.", fieldSetterName, " = function(", field.GetName(), ") {")
-		} else {
-			g.P(message.PackageName(), ".", ccTypeName, ".prototype.", fieldSetterName, " = function(", field.GetName(), ") {")
-		}
+		g.P(className, ".prototype.", fieldSetterName, " = function(", field.GetName(), ") {")
 		g.In()
-		if *field.Type == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		if oneof {
+			// Setting a member of a oneof clears all of its siblings.
+			g.P("this.", oneofClear[*field.OneofIndex], "();")
+		}
+		if isMessage(field) {
 			if eleTyp != "" {
-				g.P(fmt.Sprintf("var __data = %s.getJsonData();", field.GetName()))
-				g.P("if (__data) {")
-				g.In()
-				g.P("var __array = [];")
-				g.P("goog.array.forEach(__data, function(__item, __index) {")
-				g.In()
-				g.P("__array.push(__item.getJsonData());")
-				g.Out()
-				g.P("}, this);")
-				g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = __array;", field.GetName()))
-				g.Out()
-				g.P("} else {")
+				g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = (%s || []).map(function(__item) {", g.jsonKey(field), field.GetName()))
 				g.In()
-				g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = [];", field.GetName()))
+				g.P("return __item.getJsonData();")
 				g.Out()
-				g.P("}")
+				g.P("});")
 			} else {
-				g.P("this.jsonData_[\"" + field.GetName() + "\"] = " + field.GetName() + ".getJsonData();")
+				g.P("this.jsonData_[\"" + g.jsonKey(field) + "\"] = " + field.GetName() + ".getJsonData();")
 			}
 			g.P(fmt.Sprintf("this.%s_ = undefined;", field.GetName()))
+		} else if isRepeated(field) && g.toJSON(field, "__v") != "__v" {
+			g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = %s.map(function(__v) {", g.jsonKey(field), field.GetName()))
+			g.In()
+			g.P("return ", g.toJSON(field, "__v"), ";")
+			g.Out()
+			g.P("});")
 		} else {
-			g.P("this.jsonData_[\"" + field.GetName() + "\"] = " + field.GetName() + ";")
+			g.P("this.jsonData_[\"" + g.jsonKey(field) + "\"] = " + g.toJSON(field, field.GetName()) + ";")
 		}
 		g.Out()
 		g.P("};")
 		g.P()
-	}
-	g.Out()
 
-	// Update g.Buffer to list valid oneof types.
-	// We do this down here, after we've disambiguated the oneof type names.
-	// We go in reverse order of insertion point to avoid invalidating offsets.
-	for oi := int32(len(message.OneofDecl)); oi >= 0; oi-- {
-		ip := oneofInsertPoints[oi]
-		all := g.Buffer.Bytes()
-		rem := all[ip:]
-		g.Buffer = bytes.NewBuffer(all[:ip:ip]) // set cap so we don't scribble on rem
-		for _, field := range message.Field {
-			if field.OneofIndex == nil || *field.OneofIndex != oi {
-				continue
+		if isRepeated(field) {
+			continue
+		}
+
+		// Generate the presence check and the clear method.
+		ns = allocNames("has"+base, "clear"+base)
+		hasName, clearName := ns[0], ns[1]
+		fieldHasNames[field] = hasName
+		g.declare(className, hasName+"(): boolean;")
+		g.declare(className, clearName+"(): void;")
+
+		g.P("/**")
+		if hasPresence(field, message.proto3()) {
+			g.P(" * @return {boolean} Whether the ", field.GetName(), " is set.")
+			g.printDeprecated(field.GetOptions().GetDeprecated())
+			g.P(" */")
+			g.P(className, ".prototype.", hasName, " = function() {")
+			g.In()
+			g.P(fmt.Sprintf("return this.jsonData_[\"%s\"] != null;", g.jsonKey(field)))
+		} else {
+			// Without presence, a field set to the default is the same as
+			// an unset field.
+			g.P(" * @return {boolean} Whether the ", field.GetName(), " is set to a value other than the default.")
+			g.printDeprecated(field.GetOptions().GetDeprecated())
+			g.P(" */")
+			g.P(className, ".prototype.", hasName, " = function() {")
+			g.In()
+			if g.int64Type(field) == int64Long {
+				g.P("return !this.", fieldGetterName, "().isZero();")
+			} else {
+				g.P("return this.", fieldGetterName, "() !== ", defNames[field], ";")
 			}
-			g.P("//\t*", oneofTypeName[field])
 		}
-		g.Buffer.Write(rem)
-	}
+		g.Out()
+		g.P("};")
+		g.P()
 
-	// Oneof per-field types, discriminants and getters.
-	//
-	// Generate unexported named types for the discriminant interfaces.
-	// We shouldn't have to do this, but there was (~19 Aug 2015) a compiler/linker bug
-	// that was triggered by using anonymous interfaces here.
-	// TODO: Revisit this and consider reverting back to anonymous interfaces.
-	if len(message.OneofDecl) > 0 {
-		for oi := range message.OneofDecl {
-			dname := oneofDisc[int32(oi)]
-			g.P("type ", dname, " interface { ", dname, "() }")
+		g.P("/**")
+		g.P(" * Clears the ", field.GetName(), ".")
+		g.printDeprecated(field.GetOptions().GetDeprecated())
+		g.P(" */")
+		g.P(className, ".prototype.", clearName, " = function() {")
+		g.In()
+		g.P(fmt.Sprintf("delete this.jsonData_[\"%s\"];", g.jsonKey(field)))
+		if isMessage(field) {
+			g.P(fmt.Sprintf("this.%s_ = undefined;", field.GetName()))
 		}
+		g.Out()
+		g.P("};")
 		g.P()
+
+		if wellKnownType(field) == "google.protobuf.Any" {
+			ns = allocNames("pack"+base, "unpack"+base)
+			g.generateAnyMethods(className, ns[0], ns[1], fieldGetterName, fieldSetterName, field)
+		}
 	}
-	if len(message.Field) > 0 {
-		for _, field := range message.Field {
-			if field.OneofIndex == nil {
-				continue
-			}
-			g.P("type ", oneofTypeName[field], " struct{ ", fieldNames[field], " ", fieldTypes[field], "}")
+
+	// Oneof case enums, case getters and clear methods.
+	for oi, odp := range message.OneofDecl {
+		g.path = fmt.Sprintf("%s,%d,%d", message.path, messageOneofPath, oi)
+		caseEnum := className + "." + oneofFieldName[int32(oi)] + "Case"
+		notSet := strings.ToUpper(odp.GetName()) + "_NOT_SET"
+		members := oneofMembers(message, int32(oi))
+		if len(members) == 1 && !inRealOneof(members[0]) {
+			// The synthetic oneof of a proto3 optional field.
+			continue
+		}
+
+		g.declare(className, fmt.Sprintf("%s(): %s;", oneofDisc[int32(oi)], caseEnum))
+		g.declare(className, oneofClear[int32(oi)]+"(): void;")
+		g.declareEnum(caseEnum)
+		g.declare(caseEnum, notSet+" = 0,")
+		for _, field := range members {
+			g.declare(caseEnum, fmt.Sprintf("%s = %d,", oneofTypeName[field], field.GetNumber()))
+		}
+
+		g.P("/**")
+		g.PrintComments(fmt.Sprintf("%s,%d,%d", message.path, messageOneofPath, oi))
+		g.P(" * @enum {number}")
+		g.P(" */")
+		g.P(caseEnum, " = {")
+		g.In()
+		g.P(notSet, ": 0", trailingComma(len(members) > 0))
+		for j, field := range members {
+			g.P(oneofTypeName[field], ": ", field.Number, trailingComma(j < len(members)-1))
 		}
+		g.Out()
+		g.P("};")
 		g.P()
-		for _, field := range message.Field {
-			if field.OneofIndex == nil {
-				continue
+
+		g.P("/**")
+		g.P(" * @return {", caseEnum, "} The case of the ", odp.GetName(), " oneof.")
+		g.P(" */")
+		g.P(className, ".prototype.", oneofDisc[int32(oi)], " = function() {")
+		g.In()
+		for _, field := range members {
+			g.P(fmt.Sprintf("if (this.jsonData_[\"%s\"] != null) {", g.jsonKey(field)))
+			g.In()
+			g.P("return ", caseEnum, ".", oneofTypeName[field], ";")
+			g.Out()
+			g.P("}")
+		}
+		g.P("return ", caseEnum, ".", notSet, ";")
+		g.Out()
+		g.P("};")
+		g.P()
+
+		g.P("/**")
+		g.P(" * Clears all members of the ", odp.GetName(), " oneof.")
+		g.P(" */")
+		g.P(className, ".prototype.", oneofClear[int32(oi)], " = function() {")
+		g.In()
+		for _, field := range members {
+			g.P(fmt.Sprintf("delete this.jsonData_[\"%s\"];", g.jsonKey(field)))
+			if isMessage(field) {
+				g.P(fmt.Sprintf("this.%s_ = undefined;", field.GetName()))
 			}
-			g.P("func (*", oneofTypeName[field], ") ", oneofDisc[*field.OneofIndex], "() {}")
 		}
+		g.Out()
+		g.P("};")
 		g.P()
 	}
-	if len(message.OneofDecl) > 0 {
-		for oi := range message.OneofDecl {
-			fname := oneofFieldName[int32(oi)]
-			g.P("func (m *", ccTypeName, ") Get", fname, "() ", oneofDisc[int32(oi)], " {")
-			g.P("if m != nil { return m.", fname, " }")
-			g.P("return nil")
-			g.P("}")
+
+	g.path = message.path
+	g.generateEquals(message, className, fieldGetterNames, fieldHasNames)
+	g.generateMergeFrom(message, className, fieldGetterNames, fieldHasNames, oneofClear)
+	g.generateFieldMask(message, className, fieldGetterNames, fieldHasNames)
+	g.generateValidate(message, className, fieldGetterNames, fieldHasNames)
+	g.generateVerify(message, className)
+	if isExtendable(message) {
+		g.generateExtendable(className)
+	}
+	g.generateBinary(message, className)
+}
+
+// zeroValue returns the JavaScript expression of the value of the field of
+// the given JavaScript type when it is unset and has no explicit default.
+func (g *Generator) zeroValue(field *descriptor.FieldDescriptorProto, typename string, proto3 bool) string {
+	switch {
+	case isRepeated(field):
+		return "[]"
+	case g.int64Type(field) == int64String:
+		return "'0'"
+	case g.int64Type(field) == int64Long:
+		return g.use("goog.math.Long") + ".getZero()"
+	case wellKnownType(field) != "":
+		return "null"
+	case typename == "boolean":
+		return "false"
+	case typename == "string":
+		return "''"
+	case typename == "number":
+		return "0"
+	case *field.Type == descriptor.FieldDescriptorProto_TYPE_ENUM && !proto3:
+		// The default of a proto2 enum is its first value.
+		enum := g.enumNamed(field.GetTypeName())
+		return g.jsName(enum) + "." + enum.Value[0].GetName()
+	case *field.Type == descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return "0"
+	}
+	return "undefined"
+}
+
+// mapEntry returns the descriptor of the map entry message if the field is a
+// map field, or nil otherwise.
+func (g *Generator) mapEntry(field *descriptor.FieldDescriptorProto) *Descriptor {
+	if !isMessage(field) {
+		return nil
+	}
+	if d, ok := g.objectNamed(field.GetTypeName()).(*Descriptor); ok && d.GetOptions().GetMapEntry() {
+		return d
+	}
+	return nil
+}
+
+// generateMapField generates the accessors of a map field. The map is stored
+// in the JSON data as an object keyed by the string form of the map keys.
+// The getter and setter work on such objects, with message values wrapped by
+// their generated classes; the map getter and setter convert to and from Map
+// objects keyed by the typed keys.
+func (g *Generator) generateMapField(className, path string, field *descriptor.FieldDescriptorProto, entry *Descriptor,
+	objType, mapType, getter, setter, mapGetter, mapSetter string) {
+	keyField, valField := entry.Field[0], entry.Field[1]
+	valType, _, _ := g.JsType(entry, valField)
+	isMessage := isMessage(valField)
+	name := field.GetName()
+	key := g.jsonKey(field)
+
+	g.declare(className, fmt.Sprintf("%s(): %s;", getter, tsType(objType)))
+	g.declare(className, fmt.Sprintf("%s(%s: %s): void;", setter, name, tsType(objType)))
+	g.declare(className, fmt.Sprintf("%s(): %s;", mapGetter, tsType(mapType)))
+	g.declare(className, fmt.Sprintf("%s(%s: %s): void;", mapSetter, name, tsType(mapType)))
+
+	g.P("/**")
+	g.PrintComments(path)
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" * @return {", objType, "}")
+	g.P(" */")
+	g.P(className, ".prototype.", getter, " = function() {")
+	g.In()
+	if isMessage {
+		g.P(fmt.Sprintf("if (this.%s_) {", name))
+		g.In()
+		g.P(fmt.Sprintf("return this.%s_;", name))
+		g.Out()
+		g.P("}")
+		g.P("var v = this.jsonData_[\"" + key + "\"];")
+		g.P("if (v) {")
+		g.In()
+		g.P(fmt.Sprintf("/** @private {%s} */", objType))
+		g.P(fmt.Sprintf("this.%s_ = {};", name))
+		g.P("for (var __key in v) {")
+		g.In()
+		g.P(fmt.Sprintf("this.%s_[__key] = new %s(v[__key]);", name, valType))
+		g.Out()
+		g.P("}")
+		g.P(fmt.Sprintf("return this.%s_;", name))
+		g.Out()
+		g.P("}")
+		g.P("return {};")
+	} else if conv := g.fromJSON(valField, "v[__key]"); conv != "v[__key]" {
+		g.P(fmt.Sprintf("var v = this.jsonData_[\"%s\"] || {};", key))
+		g.P("var __obj = {};")
+		g.P("for (var __key in v) {")
+		g.In()
+		g.P("__obj[__key] = ", conv, ";")
+		g.Out()
+		g.P("}")
+		g.P("return __obj;")
+	} else {
+		g.P(fmt.Sprintf("return this.jsonData_[\"%s\"] || {};", key))
+	}
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.PrintComments(path)
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" * @param {", objType, "} ", name, " The ", name+".")
+	g.P(" */")
+	g.P(className, ".prototype.", setter, " = function(", name, ") {")
+	g.In()
+	if isMessage {
+		g.P("var __data = {};")
+		g.P("for (var __key in ", name, ") {")
+		g.In()
+		g.P("__data[__key] = ", name, "[__key].getJsonData();")
+		g.Out()
+		g.P("}")
+		g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = __data;", key))
+		g.P(fmt.Sprintf("this.%s_ = undefined;", name))
+	} else if conv := g.toJSON(valField, name+"[__key]"); conv != name+"[__key]" {
+		g.P("var __data = {};")
+		g.P("for (var __key in ", name, ") {")
+		g.In()
+		g.P("__data[__key] = ", conv, ";")
+		g.Out()
+		g.P("}")
+		g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = __data;", key))
+	} else {
+		g.P(fmt.Sprintf("this.jsonData_[\"%s\"] = %s;", key, name))
+	}
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.PrintComments(path)
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" * @return {!", mapType, "}")
+	g.P(" */")
+	g.P(className, ".prototype.", mapGetter, " = function() {")
+	g.In()
+	g.P("var __map = new Map();")
+	g.P("var __obj = this.", getter, "();")
+	g.P("for (var __key in __obj) {")
+	g.In()
+	g.P("__map.set(", g.mapKeyFromString(keyField, "__key"), ", __obj[__key]);")
+	g.Out()
+	g.P("}")
+	g.P("return __map;")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.PrintComments(path)
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" * @param {!", mapType, "} ", name, " The ", name+".")
+	g.P(" */")
+	g.P(className, ".prototype.", mapSetter, " = function(", name, ") {")
+	g.In()
+	g.P("var __obj = {};")
+	g.P(name, ".forEach(function(__value, __key) {")
+	g.In()
+	g.P("__obj[String(__key)] = __value;")
+	g.Out()
+	g.P("});")
+	g.P("this.", setter, "(__obj);")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// mapKeyFromString returns the JavaScript expression converting expr, the
+// string form of a map key in a JSON object, into the typed key.
+func (g *Generator) mapKeyFromString(keyField *descriptor.FieldDescriptorProto, expr string) string {
+	switch g.int64Type(keyField) {
+	case int64String:
+		return expr
+	case int64Long:
+		return g.use("goog.math.Long") + ".fromString(" + expr + ")"
+	}
+	switch keyField.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return expr
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return expr + " === 'true'"
+	default:
+		return "Number(" + expr + ")"
+	}
+}
+
+// inRealOneof reports whether the field is a member of a oneof declared in
+// the .proto file, rather than of the synthetic oneof of a proto3 optional
+// field.
+func inRealOneof(field *descriptor.FieldDescriptorProto) bool {
+	return field.OneofIndex != nil && !field.GetProto3Optional()
+}
+
+// hasPresence reports whether the field distinguishes being unset from being
+// set to its default value. Only the singular scalar fields of proto3 not
+// labeled optional don't.
+func hasPresence(field *descriptor.FieldDescriptorProto, proto3 bool) bool {
+	if isRepeated(field) {
+		return false
+	}
+	return !proto3 || field.OneofIndex != nil || field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE
+}
+
+// oneofMembers returns the fields of the message that belong to the given oneof.
+func oneofMembers(message *Descriptor, oneofIndex int32) (fields []*descriptor.FieldDescriptorProto) {
+	for _, field := range message.Field {
+		if field.OneofIndex != nil && *field.OneofIndex == oneofIndex {
+			fields = append(fields, field)
 		}
-		g.P()
 	}
+	return
+}
+
+// trailingComma returns the separator to print after an item of an object
+// literal: a comma unless it's the last item.
+func trailingComma(more bool) string {
+	if more {
+		return ","
+	}
+	return ""
 }
 
 // And now lots of helper functions.
@@ -1367,6 +2427,27 @@ func CamelCase(s string) string {
 // be joined with "_".
 func CamelCaseSlice(elem []string) string { return CamelCase(strings.Join(elem, "_")) }
 
+// jsonCamelCase returns the lowerCamelCase JSON name of a field, the same way
+// protoc computes json_name: underscores are dropped and the letters following
+// them are upper cased.
+func jsonCamelCase(s string) string {
+	t := make([]byte, 0, len(s))
+	upper := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_':
+			upper = true
+			continue
+		case upper && isASCIILower(c):
+			c ^= ' ' // Make it a capital letter.
+		}
+		upper = false
+		t = append(t, c)
+	}
+	return string(t)
+}
+
 // dottedSlice turns a sliced name into a dotted name.
 func dottedSlice(elem []string) string { return strings.Join(elem, ".") }
 
@@ -1417,14 +2498,19 @@ func baseName(name string) string {
 // See descriptor.proto for more information about this.
 const (
 	// tag numbers in FileDescriptorProto
-	packagePath = 2 // package
-	messagePath = 4 // message_type
-	enumPath    = 5 // enum_type
+	packagePath   = 2 // package
+	messagePath   = 4 // message_type
+	enumPath      = 5 // enum_type
+	servicePath   = 6 // service
+	extensionPath = 7 // extension
 	// tag numbers in DescriptorProto
-	messageFieldPath   = 2 // field
-	messageMessagePath = 3 // nested_type
-	messageEnumPath    = 4 // enum_type
-	messageOneofPath   = 8 // oneof_decl
+	messageFieldPath     = 2 // field
+	messageMessagePath   = 3 // nested_type
+	messageEnumPath      = 4 // enum_type
+	messageExtensionPath = 6 // extension
+	messageOneofPath     = 8 // oneof_decl
 	// tag numbers in EnumDescriptorProto
 	enumValuePath = 2 // value
+	// tag numbers in ServiceDescriptorProto
+	serviceMethodPath = 2 // method
 )
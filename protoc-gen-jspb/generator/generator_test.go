@@ -0,0 +1,1407 @@
+package generator
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	plugin "github.com/golang/protobuf/protoc-gen-go/plugin"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+var (
+	typeBool    = descriptor.FieldDescriptorProto_TYPE_BOOL
+	typeBytes   = descriptor.FieldDescriptorProto_TYPE_BYTES
+	typeDouble  = descriptor.FieldDescriptorProto_TYPE_DOUBLE
+	typeEnum    = descriptor.FieldDescriptorProto_TYPE_ENUM
+	typeFixed64 = descriptor.FieldDescriptorProto_TYPE_FIXED64
+	typeFloat   = descriptor.FieldDescriptorProto_TYPE_FLOAT
+	typeInt32   = descriptor.FieldDescriptorProto_TYPE_INT32
+	typeInt64   = descriptor.FieldDescriptorProto_TYPE_INT64
+	typeSint32  = descriptor.FieldDescriptorProto_TYPE_SINT32
+	typeSint64  = descriptor.FieldDescriptorProto_TYPE_SINT64
+	typeMessage = descriptor.FieldDescriptorProto_TYPE_MESSAGE
+	typeString  = descriptor.FieldDescriptorProto_TYPE_STRING
+	typeUint32  = descriptor.FieldDescriptorProto_TYPE_UINT32
+	typeUint64  = descriptor.FieldDescriptorProto_TYPE_UINT64
+)
+
+func field(name string, number int32, typ descriptor.FieldDescriptorProto_Type) *descriptor.FieldDescriptorProto {
+	return &descriptor.FieldDescriptorProto{
+		Name:     proto.String(name),
+		Number:   proto.Int32(number),
+		Label:    descriptor.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:     typ.Enum(),
+		JsonName: proto.String(jsonCamelCase(name)),
+	}
+}
+
+func typedField(name string, number int32, typ descriptor.FieldDescriptorProto_Type, typeName string) *descriptor.FieldDescriptorProto {
+	f := field(name, number, typ)
+	f.TypeName = proto.String(typeName)
+	return f
+}
+
+func repeated(f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Label = descriptor.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	return f
+}
+
+func required(f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Label = descriptor.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+	return f
+}
+
+func inOneof(index int32, f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.OneofIndex = proto.Int32(index)
+	return f
+}
+
+// proto3Optional returns the field with the optional label, a member of its
+// synthetic oneof.
+func proto3Optional(index int32, f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Proto3Optional = proto.Bool(true)
+	return inOneof(index, f)
+}
+
+// withDefault returns the field with the default value, as protoc writes it
+// in the descriptor.
+func withDefault(def string, f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.DefaultValue = proto.String(def)
+	return f
+}
+
+func withJstype(jstype descriptor.FieldOptions_JSType, f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Options = &descriptor.FieldOptions{Jstype: jstype.Enum()}
+	return f
+}
+
+// rule encodes a field of validation rules: a varint, a double, a string or,
+// given its encoding, a message.
+func rule(number uint64, value interface{}) []byte {
+	b := proto.NewBuffer(nil)
+	switch v := value.(type) {
+	case uint64:
+		b.EncodeVarint(number << 3)
+		b.EncodeVarint(v)
+	case float64:
+		b.EncodeVarint(number<<3 | 1)
+		b.EncodeFixed64(math.Float64bits(v))
+	case string:
+		b.EncodeVarint(number<<3 | 2)
+		b.EncodeStringBytes(v)
+	case []byte:
+		b.EncodeVarint(number<<3 | 2)
+		b.EncodeRawBytes(v)
+	}
+	return b.Bytes()
+}
+
+// rules encodes a message of validation rules.
+func rules(fields ...[]byte) []byte {
+	return bytes.Join(fields, nil)
+}
+
+// withRules returns the field with the validation rules in the field option,
+// pgvRulesField or bufRulesField, kept in the unknown fields of the options as
+// for a plugin without the extension linked in.
+func withRules(option uint64, r []byte, f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Options = &descriptor.FieldOptions{}
+	if err := proto.Unmarshal(rule(option, r), f.Options); err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// extending returns the field as an extension of the message type.
+func extending(extendee string, f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Extendee = proto.String(extendee)
+	f.JsonName = nil
+	return f
+}
+
+func message(name string, fields ...*descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	return &descriptor.DescriptorProto{Name: proto.String(name), Field: fields}
+}
+
+func enum(name string, values ...string) *descriptor.EnumDescriptorProto {
+	e := &descriptor.EnumDescriptorProto{Name: proto.String(name)}
+	for i, v := range values {
+		e.Value = append(e.Value, &descriptor.EnumValueDescriptorProto{
+			Name:   proto.String(v),
+			Number: proto.Int32(int32(i)),
+		})
+	}
+	return e
+}
+
+func deprecatedField(f *descriptor.FieldDescriptorProto) *descriptor.FieldDescriptorProto {
+	f.Options = &descriptor.FieldOptions{Deprecated: proto.Bool(true)}
+	return f
+}
+
+func deprecatedValue(e *descriptor.EnumDescriptorProto, i int) *descriptor.EnumDescriptorProto {
+	e.Value[i].Options = &descriptor.EnumValueOptions{Deprecated: proto.Bool(true)}
+	return e
+}
+
+// renumber numbers the values of the enum from first on.
+func renumber(e *descriptor.EnumDescriptorProto, first int32) *descriptor.EnumDescriptorProto {
+	for i, v := range e.Value {
+		v.Number = proto.Int32(first + int32(i))
+	}
+	return e
+}
+
+// mapEntry returns the nested message protoc generates for a map field.
+func mapEntry(name string, key, value *descriptor.FieldDescriptorProto) *descriptor.DescriptorProto {
+	m := message(name, key, value)
+	m.Options = &descriptor.MessageOptions{MapEntry: proto.Bool(true)}
+	return m
+}
+
+func rpc(name, input, output string) *descriptor.MethodDescriptorProto {
+	return &descriptor.MethodDescriptorProto{
+		Name:       proto.String(name),
+		InputType:  proto.String(input),
+		OutputType: proto.String(output),
+	}
+}
+
+func serverStreaming(m *descriptor.MethodDescriptorProto) *descriptor.MethodDescriptorProto {
+	m.ServerStreaming = proto.Bool(true)
+	return m
+}
+
+func clientStreaming(m *descriptor.MethodDescriptorProto) *descriptor.MethodDescriptorProto {
+	m.ClientStreaming = proto.Bool(true)
+	return m
+}
+
+// comment returns the source location of a leading comment.
+func comment(text string, path ...int32) *descriptor.SourceCodeInfo_Location {
+	return &descriptor.SourceCodeInfo_Location{
+		Path:            path,
+		Span:            []int32{0, 0, 0},
+		LeadingComments: proto.String(text),
+	}
+}
+
+// The fixtures, described as protoc would describe the .proto files quoted in
+// the comments.
+var (
+	// syntax = "proto3";
+	// package test.enums;
+	//
+	// // The primary colors.
+	// enum Color {
+	//   RED = 0;
+	//   // Not to be confused with lime.
+	//   GREEN = 1;
+	//   // Too dark.
+	//   BLUE = 2 [deprecated = true];
+	// }
+	//
+	// message Paint {
+	//   enum Finish {
+	//     option deprecated = true;
+	//     MATTE = 0;
+	//     GLOSS = 1;
+	//   }
+	//   Color color = 1;
+	//   Finish finish = 2 [deprecated = true];
+	//   repeated Color palette = 3;
+	// }
+	//
+	// message Tint {
+	//   option deprecated = true;
+	// }
+	enumsFile = &descriptor.FileDescriptorProto{
+		Name:     proto.String("test/enums.proto"),
+		Package:  proto.String("test.enums"),
+		Syntax:   proto.String("proto3"),
+		EnumType: []*descriptor.EnumDescriptorProto{deprecatedValue(enum("Color", "RED", "GREEN", "BLUE"), 2)},
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Paint"),
+			Field: []*descriptor.FieldDescriptorProto{
+				typedField("color", 1, typeEnum, ".test.enums.Color"),
+				deprecatedField(typedField("finish", 2, typeEnum, ".test.enums.Paint.Finish")),
+				repeated(typedField("palette", 3, typeEnum, ".test.enums.Color")),
+			},
+			EnumType: []*descriptor.EnumDescriptorProto{{
+				Name:    proto.String("Finish"),
+				Value:   enum("Finish", "MATTE", "GLOSS").Value,
+				Options: &descriptor.EnumOptions{Deprecated: proto.Bool(true)},
+			}},
+		}, {
+			Name:    proto.String("Tint"),
+			Options: &descriptor.MessageOptions{Deprecated: proto.Bool(true)},
+		}},
+		SourceCodeInfo: &descriptor.SourceCodeInfo{
+			Location: []*descriptor.SourceCodeInfo_Location{
+				comment(" The primary colors.\n", 5, 0),
+				comment(" Not to be confused with lime.\n", 5, 0, 2, 1),
+				comment(" Too dark.\n", 5, 0, 2, 2),
+			},
+		},
+	}
+
+	// syntax = "proto2";
+	// package test.aliases;
+	//
+	// enum Sign {
+	//   option allow_alias = true;
+	//   NEGATIVE = -1;
+	//   ZERO = 0;
+	//   NONE = 0;
+	//   POSITIVE = 1;
+	// }
+	//
+	// message Reading {
+	//   optional Sign sign = 1;
+	//   repeated Sign history = 2;
+	// }
+	aliasesFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/aliases.proto"),
+		Package: proto.String("test.aliases"),
+		EnumType: []*descriptor.EnumDescriptorProto{{
+			Name: proto.String("Sign"),
+			Value: []*descriptor.EnumValueDescriptorProto{
+				{Name: proto.String("NEGATIVE"), Number: proto.Int32(-1)},
+				{Name: proto.String("ZERO"), Number: proto.Int32(0)},
+				{Name: proto.String("NONE"), Number: proto.Int32(0)},
+				{Name: proto.String("POSITIVE"), Number: proto.Int32(1)},
+			},
+			Options: &descriptor.EnumOptions{AllowAlias: proto.Bool(true)},
+		}},
+		MessageType: []*descriptor.DescriptorProto{message("Reading",
+			typedField("sign", 1, typeEnum, ".test.aliases.Sign"),
+			repeated(typedField("history", 2, typeEnum, ".test.aliases.Sign")))},
+	}
+
+	// syntax = "proto2";
+	// package test.nested;
+	//
+	// // An outer message.
+	// message Outer {
+	//   // An inner message.
+	//   message Inner {
+	//     optional int32 depth = 1;
+	//   }
+	//   optional Inner inner = 1;
+	//   optional string name = 2;
+	//   // Named like the method returning the JSON data of the message.
+	//   optional string json_data = 3;
+	// }
+	nestedFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/nested.proto"),
+		Package: proto.String("test.nested"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Outer"),
+			Field: []*descriptor.FieldDescriptorProto{
+				typedField("inner", 1, typeMessage, ".test.nested.Outer.Inner"),
+				field("name", 2, typeString),
+				field("json_data", 3, typeString),
+			},
+			NestedType: []*descriptor.DescriptorProto{message("Inner", field("depth", 1, typeInt32))},
+		}},
+		SourceCodeInfo: &descriptor.SourceCodeInfo{
+			Location: []*descriptor.SourceCodeInfo_Location{
+				comment(" An outer message.\n", 4, 0),
+				comment(" An inner message.\n", 4, 0, 3, 0),
+			},
+		},
+	}
+
+	// syntax = "proto2";
+	// package test.defaults;
+	//
+	// enum Mode { FAST = 1; SLOW = 2; }
+	//
+	// message Settings {
+	//   optional int32 retries = 1 [default = 3];
+	//   optional int64 timeout = 2 [default = -1];
+	//   optional bool enabled = 3 [default = true];
+	//   optional double ratio = 4 [default = inf];
+	//   optional float epsilon = 5 [default = nan];
+	//   optional string label = 6 [default = "a \"quoted\" label"];
+	//   optional bytes magic = 7 [default = "\001\002abc"];
+	//   optional Mode mode = 8 [default = SLOW];
+	//   optional Mode fallback = 9;
+	// }
+	defaultsFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/defaults.proto"),
+		Package: proto.String("test.defaults"),
+		EnumType: []*descriptor.EnumDescriptorProto{{
+			Name: proto.String("Mode"),
+			Value: []*descriptor.EnumValueDescriptorProto{
+				{Name: proto.String("FAST"), Number: proto.Int32(1)},
+				{Name: proto.String("SLOW"), Number: proto.Int32(2)},
+			},
+		}},
+		MessageType: []*descriptor.DescriptorProto{
+			message("Settings",
+				withDefault("3", field("retries", 1, typeInt32)),
+				withDefault("-1", field("timeout", 2, typeInt64)),
+				withDefault("true", field("enabled", 3, typeBool)),
+				withDefault("inf", field("ratio", 4, typeDouble)),
+				withDefault("nan", field("epsilon", 5, typeFloat)),
+				withDefault(`a "quoted" label`, field("label", 6, typeString)),
+				withDefault(`\001\002abc`, field("magic", 7, typeBytes)),
+				withDefault("SLOW", typedField("mode", 8, typeEnum, ".test.defaults.Mode")),
+				withDefault("bell\a, smile \U0001F600, line\u2028break\ttab", field("banner", 10, typeString)),
+				typedField("fallback", 9, typeEnum, ".test.defaults.Mode"),
+			),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.int64;
+	//
+	// message Counter {
+	//   int64 id = 1;
+	//   int64 small = 2 [jstype = JS_NUMBER];
+	//   fixed64 total = 3 [jstype = JS_STRING];
+	//   repeated sint64 deltas = 4;
+	//   map<uint64, string> labels = 5;
+	// }
+	int64File = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/int64.proto"),
+		Package: proto.String("test.int64"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Counter"),
+			Field: []*descriptor.FieldDescriptorProto{
+				field("id", 1, typeInt64),
+				withJstype(descriptor.FieldOptions_JS_NUMBER, field("small", 2, typeInt64)),
+				withJstype(descriptor.FieldOptions_JS_STRING, field("total", 3, typeFixed64)),
+				repeated(field("deltas", 4, typeSint64)),
+				repeated(typedField("labels", 5, typeMessage, ".test.int64.Counter.LabelsEntry")),
+			},
+			NestedType: []*descriptor.DescriptorProto{
+				mapEntry("LabelsEntry", field("key", 1, typeUint64), field("value", 2, typeString)),
+			},
+		}},
+	}
+
+	// syntax = "proto3";
+	// package test.repeated;
+	//
+	// message Lists {
+	//   repeated int32 ids = 1;
+	//   repeated string names = 2;
+	//   repeated double scores = 3;
+	//   repeated bytes blobs = 4;
+	//   repeated Item items = 5;
+	// }
+	//
+	// message Item {
+	//   string name = 1;
+	// }
+	repeatedFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/repeated.proto"),
+		Package: proto.String("test.repeated"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{
+			message("Lists",
+				repeated(field("ids", 1, typeInt32)),
+				repeated(field("names", 2, typeString)),
+				repeated(field("scores", 3, typeDouble)),
+				repeated(field("blobs", 4, typeBytes)),
+				repeated(typedField("items", 5, typeMessage, ".test.repeated.Item")),
+			),
+			message("Item", field("name", 1, typeString)),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.maps;
+	//
+	// message Inventory {
+	//   map<string, int32> counts = 1;
+	//   map<int64, Item> items = 2;
+	// }
+	//
+	// message Item {
+	//   string name = 1;
+	// }
+	mapsFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/maps.proto"),
+		Package: proto.String("test.maps"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Inventory"),
+			Field: []*descriptor.FieldDescriptorProto{
+				repeated(typedField("counts", 1, typeMessage, ".test.maps.Inventory.CountsEntry")),
+				repeated(typedField("items", 2, typeMessage, ".test.maps.Inventory.ItemsEntry")),
+			},
+			NestedType: []*descriptor.DescriptorProto{
+				mapEntry("CountsEntry", field("key", 1, typeString), field("value", 2, typeInt32)),
+				mapEntry("ItemsEntry", field("key", 1, typeInt64), typedField("value", 2, typeMessage, ".test.maps.Item")),
+			},
+		},
+			message("Item", field("name", 1, typeString)),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.oneofs;
+	//
+	// message Event {
+	//   string id = 1;
+	//   oneof payload {
+	//     string text = 2;
+	//     Attachment attachment = 3;
+	//     int64 size = 4;
+	//   }
+	//   optional string note = 5;
+	// }
+	//
+	// message Attachment {
+	//   string url = 1;
+	// }
+	oneofsFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/oneofs.proto"),
+		Package: proto.String("test.oneofs"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Event"),
+			Field: []*descriptor.FieldDescriptorProto{
+				field("id", 1, typeString),
+				inOneof(0, field("text", 2, typeString)),
+				inOneof(0, typedField("attachment", 3, typeMessage, ".test.oneofs.Attachment")),
+				inOneof(0, field("size", 4, typeInt64)),
+				proto3Optional(1, field("note", 5, typeString)),
+			},
+			OneofDecl: []*descriptor.OneofDescriptorProto{
+				{Name: proto.String("payload")},
+				{Name: proto.String("_note")},
+			},
+		},
+			message("Attachment", field("url", 1, typeString)),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.dep;
+	//
+	// message Dep {
+	//   string name = 1;
+	// }
+	//
+	// enum Level { LOW = 0; HIGH = 1; }
+	depFile = &descriptor.FileDescriptorProto{
+		Name:        proto.String("test/dep.proto"),
+		Package:     proto.String("test.dep"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{message("Dep", field("name", 1, typeString))},
+		EnumType:    []*descriptor.EnumDescriptorProto{enum("Level", "LOW", "HIGH")},
+	}
+
+	// syntax = "proto3";
+	// package test.pub;
+	//
+	// import public "test/dep.proto";
+	//
+	// message Wrapper {
+	//   test.dep.Dep dep = 1;
+	// }
+	publicFile = &descriptor.FileDescriptorProto{
+		Name:             proto.String("test/public.proto"),
+		Package:          proto.String("test.pub"),
+		Syntax:           proto.String("proto3"),
+		Dependency:       []string{"test/dep.proto"},
+		PublicDependency: []int32{0},
+		MessageType: []*descriptor.DescriptorProto{
+			message("Wrapper", typedField("dep", 1, typeMessage, ".test.dep.Dep")),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.imports;
+	//
+	// import "test/public.proto";
+	//
+	// message User {
+	//   test.dep.Dep dep = 1;
+	//   test.dep.Level level = 2;
+	//   test.pub.Wrapper wrapper = 3;
+	// }
+	importsFile = &descriptor.FileDescriptorProto{
+		Name:       proto.String("test/imports.proto"),
+		Package:    proto.String("test.imports"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"test/public.proto"},
+		MessageType: []*descriptor.DescriptorProto{
+			message("User",
+				typedField("dep", 1, typeMessage, ".test.dep.Dep"),
+				typedField("level", 2, typeEnum, ".test.dep.Level"),
+				typedField("wrapper", 3, typeMessage, ".test.pub.Wrapper"),
+			),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.wkt;
+	//
+	// import "google/protobuf/any.proto";
+	// import "google/protobuf/duration.proto";
+	// import "google/protobuf/field_mask.proto";
+	// import "google/protobuf/struct.proto";
+	// import "google/protobuf/timestamp.proto";
+	// import "google/protobuf/wrappers.proto";
+	//
+	// message Record {
+	//   google.protobuf.Timestamp created = 1;
+	//   google.protobuf.Duration timeout = 2;
+	//   google.protobuf.Int32Value count = 3;
+	//   google.protobuf.Int64Value total = 4;
+	//   google.protobuf.BytesValue blob = 5;
+	//   google.protobuf.Struct labels = 6;
+	//   google.protobuf.Value extra = 7;
+	//   google.protobuf.ListValue items = 8;
+	//   google.protobuf.FieldMask mask = 9;
+	//   google.protobuf.Any payload = 10;
+	//   repeated google.protobuf.Timestamp history = 11;
+	// }
+	wktFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/wkt.proto"),
+		Package: proto.String("test.wkt"),
+		Syntax:  proto.String("proto3"),
+		Dependency: []string{
+			"google/protobuf/any.proto",
+			"google/protobuf/duration.proto",
+			"google/protobuf/field_mask.proto",
+			"google/protobuf/struct.proto",
+			"google/protobuf/timestamp.proto",
+			"google/protobuf/wrappers.proto",
+		},
+		MessageType: []*descriptor.DescriptorProto{
+			message("Record",
+				typedField("created", 1, typeMessage, ".google.protobuf.Timestamp"),
+				typedField("timeout", 2, typeMessage, ".google.protobuf.Duration"),
+				typedField("count", 3, typeMessage, ".google.protobuf.Int32Value"),
+				typedField("total", 4, typeMessage, ".google.protobuf.Int64Value"),
+				typedField("blob", 5, typeMessage, ".google.protobuf.BytesValue"),
+				typedField("labels", 6, typeMessage, ".google.protobuf.Struct"),
+				typedField("extra", 7, typeMessage, ".google.protobuf.Value"),
+				typedField("items", 8, typeMessage, ".google.protobuf.ListValue"),
+				typedField("mask", 9, typeMessage, ".google.protobuf.FieldMask"),
+				typedField("payload", 10, typeMessage, ".google.protobuf.Any"),
+				repeated(typedField("history", 11, typeMessage, ".google.protobuf.Timestamp")),
+			),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.wkt;
+	//
+	// import "google/protobuf/any.proto";
+	//
+	// message Envelope { google.protobuf.Any content = 1; }
+	packedFile = &descriptor.FileDescriptorProto{
+		Name:       proto.String("test/packed.proto"),
+		Package:    proto.String("test.wkt"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/any.proto"},
+		MessageType: []*descriptor.DescriptorProto{
+			message("Envelope", typedField("content", 1, typeMessage, ".google.protobuf.Any")),
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.svc;
+	//
+	// import "google/protobuf/empty.proto";
+	//
+	// message HelloRequest { string name = 1; }
+	// message HelloReply { string message = 1; }
+	//
+	// // Greets people.
+	// service Greeter {
+	//   // Says hello.
+	//   rpc SayHello(HelloRequest) returns (HelloReply);
+	//   rpc Ping(google.protobuf.Empty) returns (google.protobuf.Empty);
+	//   rpc Watch(HelloRequest) returns (stream HelloReply);
+	//   rpc Upload(stream HelloRequest) returns (HelloReply);
+	//   rpc Chat(stream HelloRequest) returns (stream HelloReply);
+	// }
+	servicesFile = &descriptor.FileDescriptorProto{
+		Name:       proto.String("test/services.proto"),
+		Package:    proto.String("test.svc"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/empty.proto"},
+		MessageType: []*descriptor.DescriptorProto{
+			message("HelloRequest", field("name", 1, typeString)),
+			message("HelloReply", field("message", 1, typeString)),
+		},
+		Service: []*descriptor.ServiceDescriptorProto{{
+			Name: proto.String("Greeter"),
+			Method: []*descriptor.MethodDescriptorProto{
+				rpc("SayHello", ".test.svc.HelloRequest", ".test.svc.HelloReply"),
+				rpc("Ping", ".google.protobuf.Empty", ".google.protobuf.Empty"),
+				serverStreaming(rpc("Watch", ".test.svc.HelloRequest", ".test.svc.HelloReply")),
+				clientStreaming(rpc("Upload", ".test.svc.HelloRequest", ".test.svc.HelloReply")),
+				clientStreaming(serverStreaming(rpc("Chat", ".test.svc.HelloRequest", ".test.svc.HelloReply"))),
+			},
+		}},
+		SourceCodeInfo: &descriptor.SourceCodeInfo{
+			Location: []*descriptor.SourceCodeInfo_Location{
+				comment(" Greets people.\n", 6, 0),
+				comment(" Says hello.\n", 6, 0, 2, 0),
+			},
+		},
+	}
+
+	// syntax = "proto2";
+	// package test.ext;
+	//
+	// message Extendable {
+	//   optional int32 id = 1;
+	//   extensions 100 to 199;
+	//   // Named like the registry of the extensions of the class.
+	//   extend Extendable { optional int32 extensions = 105; }
+	// }
+	// message Note { optional string text = 1; }
+	// enum Level { LOW = 0; HIGH = 1; }
+	//
+	// extend Extendable {
+	//   // The priority.
+	//   optional int32 priority = 100 [default = 3];
+	//   repeated Note notes = 101;
+	//   optional Level level = 103;
+	// }
+	//
+	// message Scope {
+	//   extend Extendable {
+	//     optional Note note = 102;
+	//     // Named like the static verify() of the class.
+	//     optional string verify = 104;
+	//   }
+	// }
+	extensionsFile = &descriptor.FileDescriptorProto{
+		Name:     proto.String("test/extensions.proto"),
+		Package:  proto.String("test.ext"),
+		EnumType: []*descriptor.EnumDescriptorProto{enum("Level", "LOW", "HIGH")},
+		MessageType: []*descriptor.DescriptorProto{
+			{
+				Name:  proto.String("Extendable"),
+				Field: []*descriptor.FieldDescriptorProto{field("id", 1, typeInt32)},
+				ExtensionRange: []*descriptor.DescriptorProto_ExtensionRange{
+					{Start: proto.Int32(100), End: proto.Int32(200)},
+				},
+				Extension: []*descriptor.FieldDescriptorProto{
+					extending(".test.ext.Extendable", field("extensions", 105, typeInt32)),
+				},
+			},
+			message("Note", field("text", 1, typeString)),
+			{
+				Name: proto.String("Scope"),
+				Extension: []*descriptor.FieldDescriptorProto{
+					extending(".test.ext.Extendable", typedField("note", 102, typeMessage, ".test.ext.Note")),
+					extending(".test.ext.Extendable", field("verify", 104, typeString)),
+				},
+			},
+		},
+		Extension: []*descriptor.FieldDescriptorProto{
+			extending(".test.ext.Extendable", withDefault("3", field("priority", 100, typeInt32))),
+			extending(".test.ext.Extendable", repeated(typedField("notes", 101, typeMessage, ".test.ext.Note"))),
+			extending(".test.ext.Extendable", typedField("level", 103, typeEnum, ".test.ext.Level")),
+		},
+		SourceCodeInfo: &descriptor.SourceCodeInfo{
+			Location: []*descriptor.SourceCodeInfo_Location{
+				comment(" The priority.\n", 7, 0),
+			},
+		},
+	}
+
+	// syntax = "proto2";
+	// package google.protobuf;
+	//
+	// message FieldOptions { extensions 1000 to max; }
+	// message MessageOptions { extensions 1000 to max; }
+	descriptorFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("google/protobuf/descriptor.proto"),
+		Package: proto.String("google.protobuf"),
+		MessageType: []*descriptor.DescriptorProto{
+			{
+				Name: proto.String("FieldOptions"),
+				ExtensionRange: []*descriptor.DescriptorProto_ExtensionRange{
+					{Start: proto.Int32(1000), End: proto.Int32(536870912)},
+				},
+			},
+			{
+				Name: proto.String("MessageOptions"),
+				ExtensionRange: []*descriptor.DescriptorProto_ExtensionRange{
+					{Start: proto.Int32(1000), End: proto.Int32(536870912)},
+				},
+			},
+		},
+	}
+
+	// syntax = "proto2";
+	// package test.opt;
+	//
+	// import "google/protobuf/descriptor.proto";
+	//
+	// extend google.protobuf.FieldOptions { optional string label = 50001; }
+	//
+	// message Limits {
+	//   extend google.protobuf.MessageOptions { optional int32 max_size = 50002; }
+	//   optional int32 size = 1;
+	// }
+	optionsFile = &descriptor.FileDescriptorProto{
+		Name:       proto.String("test/options.proto"),
+		Package:    proto.String("test.opt"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		MessageType: []*descriptor.DescriptorProto{
+			{
+				Name:  proto.String("Limits"),
+				Field: []*descriptor.FieldDescriptorProto{field("size", 1, typeInt32)},
+				Extension: []*descriptor.FieldDescriptorProto{
+					extending(".google.protobuf.MessageOptions", field("max_size", 50002, typeInt32)),
+				},
+			},
+		},
+		Extension: []*descriptor.FieldDescriptorProto{
+			extending(".google.protobuf.FieldOptions", field("label", 50001, typeString)),
+		},
+	}
+
+	// syntax = "proto2";
+	// package test.dts;
+	//
+	// enum Level { LOW = 0; HIGH = 1; }
+	//
+	// message Tree {
+	//   enum Shape { ROUND = 0; TALL = 1; }
+	//   message Leaf {
+	//     optional string color = 1;
+	//     extend Tree { optional Level mood = 102; }
+	//   }
+	//   optional Leaf leaf = 1;
+	//   repeated Leaf leaves = 2;
+	//   map<string, Leaf> by_name = 3;
+	//   map<int64, int32> counts = 4;
+	//   optional int64 height = 5;
+	//   repeated uint64 rings = 6;
+	//   optional Shape shape = 7;
+	//   optional Level level = 8;
+	//   oneof kind {
+	//     string name = 9;
+	//     int32 number = 10;
+	//   }
+	//   extensions 100 to 199;
+	// }
+	//
+	// extend Tree {
+	//   optional int64 age = 100;
+	//   repeated Tree.Leaf fallen = 101;
+	// }
+	//
+	// service Forest {
+	//   rpc Grow(Tree) returns (Tree);
+	//   rpc Watch(Tree) returns (stream Tree.Leaf);
+	// }
+	dtsFile = &descriptor.FileDescriptorProto{
+		Name:     proto.String("test/dts.proto"),
+		Package:  proto.String("test.dts"),
+		EnumType: []*descriptor.EnumDescriptorProto{enum("Level", "LOW", "HIGH")},
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Tree"),
+			Field: []*descriptor.FieldDescriptorProto{
+				typedField("leaf", 1, typeMessage, ".test.dts.Tree.Leaf"),
+				repeated(typedField("leaves", 2, typeMessage, ".test.dts.Tree.Leaf")),
+				repeated(typedField("by_name", 3, typeMessage, ".test.dts.Tree.ByNameEntry")),
+				repeated(typedField("counts", 4, typeMessage, ".test.dts.Tree.CountsEntry")),
+				field("height", 5, typeInt64),
+				repeated(field("rings", 6, typeUint64)),
+				typedField("shape", 7, typeEnum, ".test.dts.Tree.Shape"),
+				typedField("level", 8, typeEnum, ".test.dts.Level"),
+				inOneof(0, field("name", 9, typeString)),
+				inOneof(0, field("number", 10, typeInt32)),
+			},
+			NestedType: []*descriptor.DescriptorProto{
+				{
+					Name:  proto.String("Leaf"),
+					Field: []*descriptor.FieldDescriptorProto{field("color", 1, typeString)},
+					Extension: []*descriptor.FieldDescriptorProto{
+						extending(".test.dts.Tree", typedField("mood", 102, typeEnum, ".test.dts.Level")),
+					},
+				},
+				mapEntry("ByNameEntry", field("key", 1, typeString), typedField("value", 2, typeMessage, ".test.dts.Tree.Leaf")),
+				mapEntry("CountsEntry", field("key", 1, typeInt64), field("value", 2, typeInt32)),
+			},
+			EnumType:  []*descriptor.EnumDescriptorProto{enum("Shape", "ROUND", "TALL")},
+			OneofDecl: []*descriptor.OneofDescriptorProto{{Name: proto.String("kind")}},
+			ExtensionRange: []*descriptor.DescriptorProto_ExtensionRange{
+				{Start: proto.Int32(100), End: proto.Int32(200)},
+			},
+		}},
+		Extension: []*descriptor.FieldDescriptorProto{
+			extending(".test.dts.Tree", field("age", 100, typeInt64)),
+			extending(".test.dts.Tree", repeated(typedField("fallen", 101, typeMessage, ".test.dts.Tree.Leaf"))),
+		},
+		Service: []*descriptor.ServiceDescriptorProto{{
+			Name: proto.String("Forest"),
+			Method: []*descriptor.MethodDescriptorProto{
+				rpc("Grow", ".test.dts.Tree", ".test.dts.Tree"),
+				serverStreaming(rpc("Watch", ".test.dts.Tree", ".test.dts.Tree.Leaf")),
+			},
+		}},
+	}
+
+	// syntax = "proto3";
+	// package test.equals;
+	//
+	// message Sample {
+	//   double ratio = 1;
+	//   optional float limit = 2;
+	//   int64 id = 3;
+	//   repeated double samples = 4;
+	//   map<string, int64> totals = 5;
+	//   Sample child = 6;
+	//   oneof choice {
+	//     string text = 7;
+	//     int64 number = 8;
+	//   }
+	// }
+	equalsFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/equals.proto"),
+		Package: proto.String("test.equals"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{{
+			Name: proto.String("Sample"),
+			Field: []*descriptor.FieldDescriptorProto{
+				field("ratio", 1, typeDouble),
+				proto3Optional(0, field("limit", 2, typeFloat)),
+				field("id", 3, typeInt64),
+				repeated(field("samples", 4, typeDouble)),
+				repeated(typedField("totals", 5, typeMessage, ".test.equals.Sample.TotalsEntry")),
+				typedField("child", 6, typeMessage, ".test.equals.Sample"),
+				inOneof(1, field("text", 7, typeString)),
+				inOneof(1, field("number", 8, typeInt64)),
+			},
+			NestedType: []*descriptor.DescriptorProto{
+				mapEntry("TotalsEntry", field("key", 1, typeString), field("value", 2, typeInt64)),
+			},
+			OneofDecl: []*descriptor.OneofDescriptorProto{
+				{Name: proto.String("_limit")},
+				{Name: proto.String("choice")},
+			},
+		}},
+	}
+
+	// syntax = "proto3";
+	// package test.merge;
+	//
+	// message Part {
+	//   string name = 1;
+	//   repeated string tags = 2;
+	// }
+	//
+	// message Assembly {
+	//   oneof source {
+	//     string text = 1;
+	//     Part part = 2;
+	//     int32 count = 3;
+	//   }
+	//   repeated Part parts = 4;
+	//   repeated int32 sizes = 5;
+	//   map<string, Part> by_name = 6;
+	//   map<string, int32> counts = 7;
+	//   Part main = 8;
+	//   string label = 9;
+	// }
+	mergeFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/merge.proto"),
+		Package: proto.String("test.merge"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{
+			message("Part", field("name", 1, typeString), repeated(field("tags", 2, typeString))),
+			{
+				Name: proto.String("Assembly"),
+				Field: []*descriptor.FieldDescriptorProto{
+					inOneof(0, field("text", 1, typeString)),
+					inOneof(0, typedField("part", 2, typeMessage, ".test.merge.Part")),
+					inOneof(0, field("count", 3, typeInt32)),
+					repeated(typedField("parts", 4, typeMessage, ".test.merge.Part")),
+					repeated(field("sizes", 5, typeInt32)),
+					repeated(typedField("by_name", 6, typeMessage, ".test.merge.Assembly.ByNameEntry")),
+					repeated(typedField("counts", 7, typeMessage, ".test.merge.Assembly.CountsEntry")),
+					typedField("main", 8, typeMessage, ".test.merge.Part"),
+					field("label", 9, typeString),
+				},
+				NestedType: []*descriptor.DescriptorProto{
+					mapEntry("ByNameEntry", field("key", 1, typeString), typedField("value", 2, typeMessage, ".test.merge.Part")),
+					mapEntry("CountsEntry", field("key", 1, typeString), field("value", 2, typeInt32)),
+				},
+				OneofDecl: []*descriptor.OneofDescriptorProto{{Name: proto.String("source")}},
+			},
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.mask;
+	//
+	// message Leaf { string value = 1; }
+	//
+	// message Branch {
+	//   Leaf leaf = 1;
+	//   string label = 2;
+	//   repeated Leaf leaves = 3;
+	// }
+	//
+	// message Tree {
+	//   Branch branch = 1;
+	//   Branch other = 2;
+	//   string name = 3;
+	//   map<string, Leaf> by_name = 4;
+	// }
+	fieldMaskFile = &descriptor.FileDescriptorProto{
+		Name:    proto.String("test/mask.proto"),
+		Package: proto.String("test.mask"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptor.DescriptorProto{
+			message("Leaf", field("value", 1, typeString)),
+			message("Branch",
+				typedField("leaf", 1, typeMessage, ".test.mask.Leaf"),
+				field("label", 2, typeString),
+				repeated(typedField("leaves", 3, typeMessage, ".test.mask.Leaf"))),
+			{
+				Name: proto.String("Tree"),
+				Field: []*descriptor.FieldDescriptorProto{
+					typedField("branch", 1, typeMessage, ".test.mask.Branch"),
+					typedField("other", 2, typeMessage, ".test.mask.Branch"),
+					field("name", 3, typeString),
+					repeated(typedField("by_name", 4, typeMessage, ".test.mask.Tree.ByNameEntry")),
+				},
+				NestedType: []*descriptor.DescriptorProto{
+					mapEntry("ByNameEntry", field("key", 1, typeString), typedField("value", 2, typeMessage, ".test.mask.Leaf")),
+				},
+			},
+		},
+	}
+
+	// syntax = "proto2";
+	// package test.verify;
+	//
+	// enum Kind { BOOK = 1; DISC = 2; }
+	//
+	// message Item {
+	//   optional uint32 count = 1;
+	//   optional Kind kind = 2;
+	// }
+	//
+	// message Order {
+	//   required Item item = 1;
+	//   repeated Item items = 2;
+	//   map<string, Item> by_name = 3;
+	//   repeated Kind kinds = 4;
+	//   map<int32, sint32> totals = 5;
+	//   optional fixed64 serial = 6;
+	// }
+	verifyFile = &descriptor.FileDescriptorProto{
+		Name:     proto.String("test/verify.proto"),
+		Package:  proto.String("test.verify"),
+		EnumType: []*descriptor.EnumDescriptorProto{renumber(enum("Kind", "BOOK", "DISC"), 1)},
+		MessageType: []*descriptor.DescriptorProto{
+			message("Item", field("count", 1, typeUint32), typedField("kind", 2, typeEnum, ".test.verify.Kind")),
+			{
+				Name: proto.String("Order"),
+				Field: []*descriptor.FieldDescriptorProto{
+					required(typedField("item", 1, typeMessage, ".test.verify.Item")),
+					repeated(typedField("items", 2, typeMessage, ".test.verify.Item")),
+					repeated(typedField("by_name", 3, typeMessage, ".test.verify.Order.ByNameEntry")),
+					repeated(typedField("kinds", 4, typeEnum, ".test.verify.Kind")),
+					repeated(typedField("totals", 5, typeMessage, ".test.verify.Order.TotalsEntry")),
+					field("serial", 6, typeFixed64),
+				},
+				NestedType: []*descriptor.DescriptorProto{
+					mapEntry("ByNameEntry", field("key", 1, typeString), typedField("value", 2, typeMessage, ".test.verify.Item")),
+					mapEntry("TotalsEntry", field("key", 1, typeInt32), field("value", 2, typeSint32)),
+				},
+			},
+		},
+	}
+
+	// syntax = "proto3";
+	// package test.validate;
+	//
+	// import "buf/validate/validate.proto";
+	// import "validate/validate.proto";
+	//
+	// enum Status { UNKNOWN = 0; ACTIVE = 1; }
+	//
+	// message Address {
+	//   string city = 1 [(validate.rules).string.min_len = 1];
+	//   string code = 2 [(buf.validate.field).string.pattern = "(?i)^(?P<country>[a-z]{2})-\\d+\\z"];
+	// }
+	//
+	// message User {
+	//   string name = 1 [(validate.rules).string = {min_len: 2, max_len: 20, pattern: "^[a-z]+$"}];
+	//   int32 age = 2 [(validate.rules).int32 = {gte: 0, lt: 150}];
+	//   double score = 3 [(buf.validate.field).double = {gt: 10, lt: 0}];
+	//   Status status = 4 [(validate.rules).enum.defined_only = true];
+	//   Address address = 5 [(validate.rules).message.required = true];
+	//   repeated string tags = 6 [(buf.validate.field).repeated = {min_items: 1, max_items: 3, items: {string: {len: 3}}}];
+	//   repeated Address others = 7;
+	//   int64 id = 8 [(buf.validate.field) = {required: true, int64: {gt: 0}}];
+	//   optional string nickname = 9 [(buf.validate.field).string.max_len = 8];
+	//   Address previous = 10 [(validate.rules).message.skip = true];
+	// }
+	validateFile = &descriptor.FileDescriptorProto{
+		Name:       proto.String("test/validate.proto"),
+		Package:    proto.String("test.validate"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"buf/validate/validate.proto", "validate/validate.proto"},
+		EnumType:   []*descriptor.EnumDescriptorProto{enum("Status", "UNKNOWN", "ACTIVE")},
+		MessageType: []*descriptor.DescriptorProto{
+			message("Address", withRules(pgvRulesField, rule(stringRulesField, rule(2, uint64(1))), field("city", 1, typeString)),
+				withRules(bufRulesField, rule(stringRulesField, rule(6, `(?i)^(?P<country>[a-z]{2})-\d+\z`)), field("code", 2, typeString))),
+			{
+				Name: proto.String("User"),
+				Field: []*descriptor.FieldDescriptorProto{
+					withRules(pgvRulesField, rule(stringRulesField, rules(rule(2, uint64(2)), rule(3, uint64(20)), rule(6, "^[a-z]+$"))),
+						field("name", 1, typeString)),
+					withRules(pgvRulesField, rule(3, rules(rule(5, uint64(0)), rule(2, uint64(150)))), field("age", 2, typeInt32)),
+					withRules(bufRulesField, rule(2, rules(rule(4, 10.0), rule(2, 0.0))), field("score", 3, typeDouble)),
+					withRules(pgvRulesField, rule(enumRulesField, rule(2, uint64(1))), typedField("status", 4, typeEnum, ".test.validate.Status")),
+					withRules(pgvRulesField, rule(messageRulesField, rule(2, uint64(1))),
+						typedField("address", 5, typeMessage, ".test.validate.Address")),
+					withRules(bufRulesField, rule(repeatedRulesField, rules(rule(1, uint64(1)), rule(2, uint64(3)),
+						rule(4, rule(stringRulesField, rule(19, uint64(3)))))), repeated(field("tags", 6, typeString))),
+					repeated(typedField("others", 7, typeMessage, ".test.validate.Address")),
+					withRules(bufRulesField, rules(rule(requiredRuleField, uint64(1)), rule(4, rule(4, uint64(0)))), field("id", 8, typeInt64)),
+					withRules(bufRulesField, rule(stringRulesField, rule(3, uint64(8))), proto3Optional(0, field("nickname", 9, typeString))),
+					withRules(pgvRulesField, rule(messageRulesField, rule(1, uint64(1))),
+						typedField("previous", 10, typeMessage, ".test.validate.Address")),
+				},
+				OneofDecl: []*descriptor.OneofDescriptorProto{{Name: proto.String("_nickname")}},
+			},
+		},
+	}
+)
+
+var goldenTests = []struct {
+	name      string // The directory of the golden files in testdata.
+	parameter string
+	files     []*descriptor.FileDescriptorProto // All the files, dependencies first.
+	generate  []string                          // The files to generate.
+}{
+	{"enums", "", []*descriptor.FileDescriptorProto{enumsFile}, []string{"test/enums.proto"}},
+	{"enums_canonical", "json=canonical", []*descriptor.FileDescriptorProto{enumsFile}, []string{"test/enums.proto"}},
+	{"aliases_canonical", "json=canonical", []*descriptor.FileDescriptorProto{aliasesFile}, []string{"test/aliases.proto"}},
+	{"nested", "", []*descriptor.FileDescriptorProto{nestedFile}, []string{"test/nested.proto"}},
+	{"defaults", "", []*descriptor.FileDescriptorProto{defaultsFile}, []string{"test/defaults.proto"}},
+	{"defaults_canonical", "json=canonical", []*descriptor.FileDescriptorProto{defaultsFile}, []string{"test/defaults.proto"}},
+	{"int64", "", []*descriptor.FileDescriptorProto{int64File}, []string{"test/int64.proto"}},
+	{"int64_string", "int64=string", []*descriptor.FileDescriptorProto{int64File}, []string{"test/int64.proto"}},
+	{"int64_long", "int64=goog.math.Long", []*descriptor.FileDescriptorProto{int64File}, []string{"test/int64.proto"}},
+	{"repeated", "", []*descriptor.FileDescriptorProto{repeatedFile}, []string{"test/repeated.proto"}},
+	{"maps", "", []*descriptor.FileDescriptorProto{mapsFile}, []string{"test/maps.proto"}},
+	{"oneofs", "", []*descriptor.FileDescriptorProto{oneofsFile}, []string{"test/oneofs.proto"}},
+	{"imports", "", []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile}, []string{"test/imports.proto"}},
+	{"public", "", []*descriptor.FileDescriptorProto{depFile, publicFile}, []string{"test/public.proto"}},
+	{"imports_esm", "target=esm", []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile}, []string{"test/imports.proto"}},
+	{"public_esm", "target=esm", []*descriptor.FileDescriptorProto{depFile, publicFile}, []string{"test/public.proto"}},
+	{"imports_goog_module", "target=goog.module", []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile}, []string{"test/imports.proto"}},
+	{"public_goog_module", "target=goog.module", []*descriptor.FileDescriptorProto{depFile, publicFile}, []string{"test/public.proto"}},
+	{"wkt", "", []*descriptor.FileDescriptorProto{wktFile}, []string{"test/wkt.proto"}},
+	{"wkt_canonical", "json=canonical", []*descriptor.FileDescriptorProto{wktFile}, []string{"test/wkt.proto"}},
+	{"wkt_esm", "target=esm", []*descriptor.FileDescriptorProto{wktFile}, []string{"test/wkt.proto"}},
+	{"wkt_goog_module", "target=goog.module", []*descriptor.FileDescriptorProto{wktFile}, []string{"test/wkt.proto"}},
+	{"wkt_package", "", []*descriptor.FileDescriptorProto{wktFile, packedFile}, []string{"test/wkt.proto", "test/packed.proto"}},
+	{"services", "", []*descriptor.FileDescriptorProto{servicesFile}, []string{"test/services.proto"}},
+	{"services_esm", "target=esm", []*descriptor.FileDescriptorProto{servicesFile}, []string{"test/services.proto"}},
+	{"services_goog_module", "target=goog.module", []*descriptor.FileDescriptorProto{servicesFile}, []string{"test/services.proto"}},
+	{"extensions", "", []*descriptor.FileDescriptorProto{extensionsFile}, []string{"test/extensions.proto"}},
+	{"extensions_esm", "target=esm,json=canonical", []*descriptor.FileDescriptorProto{extensionsFile}, []string{"test/extensions.proto"}},
+	{"options", "", []*descriptor.FileDescriptorProto{descriptorFile, optionsFile}, []string{"test/options.proto"}},
+	{"options_esm", "target=esm", []*descriptor.FileDescriptorProto{descriptorFile, optionsFile}, []string{"test/options.proto"}},
+	{"options_dts_goog_module", "dts=true,target=goog.module", []*descriptor.FileDescriptorProto{descriptorFile, optionsFile}, []string{"test/options.proto"}},
+	{"dts", "dts=true,int64=goog.math.Long", []*descriptor.FileDescriptorProto{dtsFile}, []string{"test/dts.proto"}},
+	{"dts_esm", "dts=true,target=esm,int64=string", []*descriptor.FileDescriptorProto{dtsFile}, []string{"test/dts.proto"}},
+	{"dts_goog_module", "dts=true,target=goog.module,int64=goog.math.Long,json=canonical", []*descriptor.FileDescriptorProto{dtsFile}, []string{"test/dts.proto"}},
+	{"imports_dts", "dts=true", []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile}, []string{"test/imports.proto"}},
+	{"imports_dts_esm", "dts=true,target=esm", []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile}, []string{"test/imports.proto"}},
+	{"imports_dts_goog_module", "dts=true,target=goog.module", []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile}, []string{"test/imports.proto"}},
+	{"public_dts", "dts=true", []*descriptor.FileDescriptorProto{depFile, publicFile}, []string{"test/public.proto"}},
+	{"public_dts_esm", "dts=true,target=esm", []*descriptor.FileDescriptorProto{depFile, publicFile}, []string{"test/public.proto"}},
+	{"public_dts_goog_module", "dts=true,target=goog.module", []*descriptor.FileDescriptorProto{depFile, publicFile}, []string{"test/public.proto"}},
+	{"wkt_dts", "dts=true", []*descriptor.FileDescriptorProto{wktFile}, []string{"test/wkt.proto"}},
+	{"equals", "", []*descriptor.FileDescriptorProto{equalsFile}, []string{"test/equals.proto"}},
+	{"equals_int64_long", "int64=goog.math.Long,json=canonical", []*descriptor.FileDescriptorProto{equalsFile}, []string{"test/equals.proto"}},
+	{"merge", "", []*descriptor.FileDescriptorProto{mergeFile}, []string{"test/merge.proto"}},
+	{"fieldmask", "", []*descriptor.FileDescriptorProto{fieldMaskFile}, []string{"test/mask.proto"}},
+	{"verify", "", []*descriptor.FileDescriptorProto{verifyFile}, []string{"test/verify.proto"}},
+	{"verify_canonical", "json=canonical", []*descriptor.FileDescriptorProto{verifyFile}, []string{"test/verify.proto"}},
+	{"validate", "", []*descriptor.FileDescriptorProto{validateFile}, []string{"test/validate.proto"}},
+	{"validate_int64_long", "int64=goog.math.Long,json=canonical", []*descriptor.FileDescriptorProto{validateFile}, []string{"test/validate.proto"}},
+}
+
+// runGenerator runs the generator end to end, as protoc-gen-jspb does.
+func runGenerator(req *plugin.CodeGeneratorRequest) (*plugin.CodeGeneratorResponse, error) {
+	g := New()
+	g.Request = req
+	if err := g.CommandLineParameters(req.GetParameter()); err != nil {
+		return nil, err
+	}
+	if err := g.WrapTypes(); err != nil {
+		return nil, err
+	}
+	if err := g.SetPackageNames(); err != nil {
+		return nil, err
+	}
+	if err := g.BuildTypeNameMap(); err != nil {
+		return nil, err
+	}
+	if err := g.GenerateAllFiles(); err != nil {
+		return nil, err
+	}
+	return g.Response, nil
+}
+
+func TestGolden(t *testing.T) {
+	for _, tt := range goldenTests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := runGenerator(&plugin.CodeGeneratorRequest{
+				FileToGenerate: tt.generate,
+				Parameter:      proto.String(tt.parameter),
+				ProtoFile:      tt.files,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range resp.File {
+				golden := filepath.Join("testdata", tt.name, f.GetName())
+				if *update {
+					if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(golden, []byte(f.GetContent()), 0644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				want, err := ioutil.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := f.GetContent(); got != string(want) {
+					t.Errorf("%s differs from %s; run go test -update to update it\ngot:\n%s", f.GetName(), golden, got)
+				}
+			}
+		})
+	}
+}
+
+// The runtime tests run the scripts of testdata/runtime with node, next to
+// the ES modules generated for the fixtures, checking that the generated code
+// works rather than only that it is unchanged.
+var runtimeTests = []struct {
+	script    string
+	parameter string
+	files     []*descriptor.FileDescriptorProto
+	generate  []string
+}{
+	{"repeated.js", "target=esm", []*descriptor.FileDescriptorProto{repeatedFile}, []string{"test/repeated.proto"}},
+	{"equals.js", "target=esm", []*descriptor.FileDescriptorProto{equalsFile}, []string{"test/equals.proto"}},
+	{"merge.js", "target=esm", []*descriptor.FileDescriptorProto{mergeFile}, []string{"test/merge.proto"}},
+	{"fieldmask.js", "target=esm", []*descriptor.FileDescriptorProto{fieldMaskFile}, []string{"test/mask.proto"}},
+	{"verify.js", "target=esm", []*descriptor.FileDescriptorProto{verifyFile}, []string{"test/verify.proto"}},
+	{"verify_canonical.js", "target=esm,json=canonical", []*descriptor.FileDescriptorProto{verifyFile}, []string{"test/verify.proto"}},
+	{"validate.js", "target=esm", []*descriptor.FileDescriptorProto{validateFile}, []string{"test/validate.proto"}},
+	{"any.js", "target=esm", []*descriptor.FileDescriptorProto{wktFile, packedFile}, []string{"test/wkt.proto", "test/packed.proto"}},
+	{"options.js", "target=esm", []*descriptor.FileDescriptorProto{descriptorFile, optionsFile}, []string{"test/options.proto"}},
+}
+
+func TestRuntime(t *testing.T) {
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is not installed")
+	}
+	stub, err := ioutil.ReadFile(filepath.Join("testdata", "runtime", "google-protobuf.js"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range runtimeTests {
+		t.Run(tt.script, func(t *testing.T) {
+			resp, err := runGenerator(&plugin.CodeGeneratorRequest{
+				FileToGenerate: tt.generate,
+				Parameter:      proto.String(tt.parameter),
+				ProtoFile:      tt.files,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			script, err := ioutil.ReadFile(filepath.Join("testdata", "runtime", tt.script))
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir, err := ioutil.TempDir("", "jspb")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			files := map[string][]byte{
+				"package.json": []byte(`{"type": "module"}`),
+				"node_modules/google-protobuf/package.json": []byte(`{"type": "module", "main": "index.js"}`),
+				"node_modules/google-protobuf/index.js":     stub,
+				tt.script:                                   script,
+			}
+			for _, f := range resp.File {
+				files[f.GetName()] = []byte(f.GetContent())
+			}
+			for name, content := range files {
+				name = filepath.Join(dir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(name, content, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cmd := exec.Command(node, tt.script)
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("%s failed: %v\n%s", tt.script, err, out)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, tt := range []struct {
+		desc      string
+		parameter string
+		files     []*descriptor.FileDescriptorProto
+		generate  []string
+		want      string
+	}{
+		{
+			desc:      "unknown target",
+			parameter: "target=amd",
+			files:     []*descriptor.FileDescriptorProto{enumsFile},
+			generate:  []string{"test/enums.proto"},
+			want:      "unknown target: amd",
+		},
+		{
+			desc:      "goog.math.Long in ES module",
+			parameter: "target=esm,int64=goog.math.Long",
+			files:     []*descriptor.FileDescriptorProto{int64File},
+			generate:  []string{"test/int64.proto"},
+			want:      "int64=goog.math.Long requires the Closure library, not available to target=esm",
+		},
+		{
+			desc:     "missing file",
+			files:    []*descriptor.FileDescriptorProto{enumsFile},
+			generate: []string{"test/missing.proto"},
+			want:     "could not find file named test/missing.proto",
+		},
+		{
+			desc:     "inconsistent packages",
+			files:    []*descriptor.FileDescriptorProto{enumsFile, nestedFile},
+			generate: []string{"test/enums.proto", "test/nested.proto"},
+			want:     "test/nested.proto: inconsistent package names: test.nested test.enums",
+		},
+		{
+			desc: "duplicate type",
+			files: []*descriptor.FileDescriptorProto{enumsFile, {
+				Name:     proto.String("test/colors.proto"),
+				Package:  proto.String("test.enums"),
+				Syntax:   proto.String("proto3"),
+				EnumType: []*descriptor.EnumDescriptorProto{enum("Color", "BLACK")},
+			}},
+			generate: []string{"test/colors.proto"},
+			want:     "test/colors.proto: test.enums.Color is already defined in test/enums.proto",
+		},
+		{
+			desc: "enum helpers named like a message",
+			files: []*descriptor.FileDescriptorProto{{
+				Name:        proto.String("test/util.proto"),
+				Package:     proto.String("test.util"),
+				EnumType:    []*descriptor.EnumDescriptorProto{enum("Color", "RED")},
+				MessageType: []*descriptor.DescriptorProto{message("ColorUtil")},
+			}},
+			generate: []string{"test/util.proto"},
+			want:     "test/util.proto: the name of the helpers of the enum Color is taken by test.util.ColorUtil in test/util.proto",
+		},
+		{
+			desc: "type not imported",
+			files: []*descriptor.FileDescriptorProto{nestedFile, {
+				Name:        proto.String("test/orphan.proto"),
+				Package:     proto.String("test.orphan"),
+				MessageType: []*descriptor.DescriptorProto{message("Orphan", typedField("outer", 1, typeMessage, ".test.nested.Outer"))},
+			}},
+			generate: []string{"test/orphan.proto"},
+			want:     "test/orphan.proto: can't find publicly imported dependency for .test.nested.Outer",
+		},
+		{
+			desc: "unsupported pattern",
+			files: []*descriptor.FileDescriptorProto{{
+				Name:    proto.String("test/pattern.proto"),
+				Package: proto.String("test.pattern"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*descriptor.DescriptorProto{message("Word",
+					withRules(pgvRulesField, rule(stringRulesField, rule(6, `^(?=a)`)), field("text", 1, typeString)))},
+				SourceCodeInfo: &descriptor.SourceCodeInfo{Location: []*descriptor.SourceCodeInfo_Location{
+					{Path: []int32{4, 0, 2, 0}, Span: []int32{4, 2, 60}},
+				}},
+			}},
+			generate: []string{"test/pattern.proto"},
+			want:     "test/pattern.proto:5:3: bad pattern of text: error parsing regexp: invalid or unsupported Perl syntax: `(?=`",
+		},
+		{
+			desc: "malformed validation rules",
+			files: []*descriptor.FileDescriptorProto{{
+				Name:    proto.String("test/malformed.proto"),
+				Package: proto.String("test.malformed"),
+				Syntax:  proto.String("proto3"),
+				MessageType: []*descriptor.DescriptorProto{message("Word",
+					withRules(pgvRulesField, []byte{0x72, 0x05, 0x10}, field("text", 1, typeString)))},
+				SourceCodeInfo: &descriptor.SourceCodeInfo{Location: []*descriptor.SourceCodeInfo_Location{
+					{Path: []int32{4, 0, 2, 0}, Span: []int32{4, 2, 60}},
+				}},
+			}},
+			generate: []string{"test/malformed.proto"},
+			want:     "test/malformed.proto:5:3: malformed validation rules of text: unexpected EOF",
+		},
+	} {
+		_, err := runGenerator(&plugin.CodeGeneratorRequest{
+			FileToGenerate: tt.generate,
+			Parameter:      proto.String(tt.parameter),
+			ProtoFile:      tt.files,
+		})
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("%s: got error %v, want %q", tt.desc, err, tt.want)
+		}
+	}
+}
+
+func TestPackageNamesPerGenerator(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		g := New()
+		g.Request = &plugin.CodeGeneratorRequest{
+			FileToGenerate: []string{"test/imports.proto"},
+			ProtoFile:      []*descriptor.FileDescriptorProto{depFile, publicFile, importsFile},
+		}
+		if err := g.WrapTypes(); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.SetPackageNames(); err != nil {
+			t.Fatal(err)
+		}
+		if got := g.fileByName("test/dep.proto").PackageName(); got != "test_dep" {
+			t.Errorf("run %d: package name of test/dep.proto is %q, want %q", i+1, got, "test_dep")
+		}
+	}
+}
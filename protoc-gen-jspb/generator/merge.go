@@ -0,0 +1,153 @@
+/*
+ * Merging of the generated messages. mergeFrom() follows the merge rules of
+ * protocol buffers, working on the JSON data so the values keep the forms
+ * they are stored in.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// generateMergeFrom generates the mergeFrom method of the message, given the
+// names of the getters and presence checks of its fields and of the methods
+// clearing its oneofs, by oneof index.
+func (g *Generator) generateMergeFrom(message *Descriptor, className string,
+	getters, hasNames map[*descriptor.FieldDescriptorProto]string, oneofClear map[int32]string) {
+	g.declare(className, "mergeFrom(other: "+className+"): void;")
+
+	g.P("/**")
+	g.P(" * Merges another message into the message: the fields set in the other")
+	g.P(" * message overwrite the singular fields, the repeated fields are")
+	g.P(" * appended, the messages are merged recursively, the maps are merged by")
+	g.P(" * key and the oneofs take the member set in the other message. The values")
+	g.P(" * are copied.")
+	g.P(" * @param {!", className, "} other The other message.")
+	g.P(" */")
+	g.P(className, ".prototype.mergeFrom = function(other) {")
+	g.In()
+	if len(message.Field) > 0 {
+		g.P("var v;")
+	}
+	for _, field := range message.Field {
+		key := g.jsonKey(field)
+		this := fmt.Sprintf("this.jsonData_[\"%s\"]", key)
+		// The cached wrappers of the messages are rebuilt from the new data.
+		cached := isMessage(field)
+		if entry := g.mapEntry(field); entry != nil {
+			cached = isMessage(entry.Field[1])
+		}
+
+		g.P(fmt.Sprintf("v = other.jsonData_[\"%s\"];", key))
+		switch {
+		case g.mapEntry(field) != nil:
+			g.P("if (v != null) {")
+			g.In()
+			g.P(this, " = ", this, " || {};")
+			g.P("for (var __key in v) {")
+			g.In()
+			g.P(this, "[__key] = ", g.helper("copyJSON"), "(v[__key]);")
+			g.Out()
+			g.P("}")
+		case isRepeated(field):
+			g.P("if (v != null && v.length) {")
+			g.In()
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+				g.P(this, " = (", this, " || []).concat(", g.helper("copyJSON"), "(v));")
+			} else {
+				g.P(this, " = (", this, " || []).concat(v);")
+			}
+		default:
+			g.P("if (other.", hasNames[field], "()) {")
+			g.In()
+			// The statement merging the value into the value set in the
+			// message, if any.
+			var merge string
+			switch wellKnownType(field) {
+			case "":
+				if isMessage(field) {
+					merge = fmt.Sprintf("this.%s().mergeFrom(other.%s());", getters[field], getters[field])
+				}
+			case "google.protobuf.Struct":
+				merge = fmt.Sprintf("Object.assign(%s, %s(v));", this, g.helper("copyJSON"))
+			case "google.protobuf.ListValue":
+				merge = fmt.Sprintf("%s = %s.concat(%s(v));", this, this, g.helper("copyJSON"))
+			case "google.protobuf.FieldMask":
+				merge = fmt.Sprintf("%s = %s(%s(%s).concat(%s(v)));", this, g.helper("fieldMaskToJSON"),
+					g.helper("fieldMaskFromJSON"), this, g.helper("fieldMaskFromJSON"))
+			}
+			if merge != "" {
+				g.P("if (this.", hasNames[field], "()) {")
+				g.In()
+				g.P(merge)
+				g.Out()
+				g.P("} else {")
+				g.In()
+			}
+			if inRealOneof(field) {
+				g.P("this.", oneofClear[field.GetOneofIndex()], "();")
+			}
+			if field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+				g.P(this, " = ", g.helper("copyJSON"), "(v);")
+			} else {
+				g.P(this, " = v;")
+			}
+			if merge != "" {
+				g.Out()
+				g.P("}")
+			}
+		}
+		if cached {
+			g.P(fmt.Sprintf("this.%s_ = undefined;", field.GetName()))
+		}
+		g.Out()
+		g.P("}")
+	}
+	if isExtendable(message) {
+		g.P(g.helper("mergeExtensions"), "(", className, ".extensions, this.jsonData_, other.jsonData_);")
+	}
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// The helper functions of mergeFrom.
+var mergeHelpers = map[string]jsHelper{
+	"mergeExtensions": {
+		doc: `Merges the extensions in the JSON data of a message, the keys in
+brackets, into the JSON data of another message of the same type. The values
+of the repeated extensions are appended, the messages of the registered
+extensions are merged and the other values overwrite the previous ones.
+@param {!Object.<number, !Object>} extensions The registered extensions of the
+    type of the messages.
+@param {!Object} to The JSON data of the message merged into.
+@param {!Object} from The JSON data of the merged message.`,
+		params: "extensions, to, from",
+		body: `var byName = {};
+for (var number in extensions) {
+	byName['[' + extensions[number].fullName + ']'] = extensions[number];
+}
+for (var key in from) {
+	var v = from[key];
+	if (key.charAt(0) !== '[' || v == null) {
+		continue;
+	}
+	if (Array.isArray(v)) {
+		to[key] = (to[key] || []).concat($(copyJSON)(v));
+		continue;
+	}
+	var ext = byName[key];
+	var value = ext && to[key] != null ? ext.fromJSON(to[key]) : null;
+	if (value && typeof value.mergeFrom === 'function') {
+		value.mergeFrom(ext.fromJSON(v));
+	} else {
+		to[key] = $(copyJSON)(v);
+	}
+}`,
+	},
+}
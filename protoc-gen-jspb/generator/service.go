@@ -0,0 +1,536 @@
+/*
+ * Clients of the services defined in the .proto files. Every service gets a
+ * client class with a method per RPC, which sends the JSON data of the
+ * request through a pluggable transport and resolves with the response
+ * wrapped by its generated class.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The Closure type of the transports of the clients, and the TypeScript
+// declarations of its methods. call sends the JSON data of a request to a
+// method of a service, given by their full names, and resolves with the JSON
+// data of the response. stream, if the transport supports server-streaming
+// RPCs, passes the JSON data of each response to onData and calls onEnd with
+// the error ending the stream or null, returning the function cancelling it.
+// The codec, null if the messages can't be, converts the JSON data to and from
+// the binary wire format.
+const (
+	transportType = "{call: function(string, string, !Object, ?Object=): !Promise.<*>, " +
+		"stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}"
+	transportCall   = "call(service: string, method: string, data: Object, codec?: Object | null): Promise<any>;"
+	transportStream = "stream?(service: string, method: string, data: Object, codec: Object | null, " +
+		"onData: (data: any) => void, onEnd: (error: Error | null) => void): () => void;"
+)
+
+// clientName returns the JavaScript name of the client class of the service
+// defined in the current file.
+func (g *Generator) clientName(service *descriptor.ServiceDescriptorProto) string {
+	return g.topLevelName(CamelCase(service.GetName()) + "Client")
+}
+
+// generateService generates the client class of the service, the index-th
+// service of the current file.
+func (g *Generator) generateService(service *descriptor.ServiceDescriptorProto, index int) {
+	path := fmt.Sprintf("%d,%d", servicePath, index)
+	g.path = path
+	className := g.clientName(service)
+	fullName := service.GetName()
+	if pkg := g.file.GetPackage(); pkg != "" {
+		fullName = pkg + "." + fullName
+	}
+
+	transport := className + ".Transport"
+	g.declareClass(className)
+	g.declare(className, fmt.Sprintf("constructor(transport: %s | string);", transport))
+	g.declare(className, fmt.Sprintf("static grpcWebTransport(baseUrl: string, text?: boolean): %s;", transport))
+
+	g.P("/**")
+	if g.PrintComments(path) {
+		g.P(" *")
+	}
+	g.P(" * A client of the ", fullName, " service.")
+	g.P(" * @param {!", transport, "|string} transport")
+	g.P(" *     The transport sending the requests, or the base URL of the JSON-over-HTTP")
+	g.P(" *     gateway.")
+	g.P(" * @constructor")
+	g.P(" */")
+	g.P(g.define(className), " = function(transport) {")
+	g.In()
+	g.P("/**")
+	g.P(" * @private {!", transport, "}")
+	g.P(" */")
+	g.P("this.transport_ = typeof transport === 'string' ? ", g.httpTransport(), "(transport) : transport;")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.declareInterface(transport)
+	g.declare(transport, transportCall)
+	g.declare(transport, transportStream)
+	g.P("/**")
+	g.P(" * The transport of the client, a local fake or one created by the")
+	g.P(" * transport factories.")
+	g.P(" * @typedef {", transportType, "}")
+	g.P(" */")
+	g.P(transport, ";")
+	g.P()
+
+	g.P("/**")
+	g.P(" * Creates the transport sending the requests to a grpc-web server or proxy,")
+	g.P(" * in the binary format or, with text set, the base64 text format of the")
+	g.P(" * grpc-web protocol. It supports server-streaming RPCs.")
+	g.P(" * @param {string} baseUrl The base URL of the server.")
+	g.P(" * @param {boolean=} opt_text Whether to use the text format.")
+	g.P(" * @return {!", transport, "} The transport.")
+	g.P(" */")
+	g.P(className, ".grpcWebTransport = function(baseUrl, opt_text) {")
+	g.In()
+	g.P("return ", g.helper("grpcWebTransport"), "(baseUrl, opt_text);")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	for i, method := range service.Method {
+		path := fmt.Sprintf("%s,%d,%d", path, serviceMethodPath, i)
+		g.path = path
+		if method.GetClientStreaming() {
+			// Neither grpc-web nor the JSON-over-HTTP gateways stream
+			// requests, so the generated code only notes the missing method.
+			kind := "client-streaming"
+			if method.GetServerStreaming() {
+				kind = "bidirectional streaming"
+			}
+			g.P("// The ", kind, " RPC ", method.GetName(), " has no method: neither grpc-web nor")
+			g.P("// the JSON-over-HTTP gateways stream requests.")
+			g.P()
+			continue
+		}
+		name := CamelCase(method.GetName())
+		name = strings.ToLower(name[:1]) + name[1:]
+		reqType, reqClass := g.rpcType(method.GetInputType())
+		respType, respClass := g.rpcType(method.GetOutputType())
+
+		// The JSON data sent for the request.
+		param, data := "request", "request"
+		switch {
+		case reqType == "void":
+			param, data = "", "{}"
+		case reqClass != "":
+			data = "request.getJsonData()"
+		}
+		// The conversion of the JSON data of a response.
+		var resp string
+		switch {
+		case respType == "void":
+			resp = ""
+		case respClass != "":
+			resp = "new " + respClass + "(data)"
+		default:
+			resp = "data"
+		}
+		// The codec of the binary transports. The well-known types other
+		// than google.protobuf.Empty have no binary serialization here.
+		codec := "null"
+		if reqType != "*" && respType != "*" {
+			codec = g.helper("messageCodec") + "(" + orNull(reqClass) + ", " + orNull(respClass) + ")"
+		}
+
+		tsParams := ""
+		if param != "" {
+			tsParams = "request: " + tsType(reqType)
+		}
+		g.P("/**")
+		g.PrintComments(path)
+		if param != "" {
+			g.P(" * @param {", reqType, "} request The request.")
+		}
+		if method.GetServerStreaming() {
+			onMessage := "function(" + strings.TrimPrefix(respType, "void") + ")"
+			if tsParams != "" {
+				tsParams += ", "
+			}
+			tsMessage := "(response: " + tsType(respType) + ") => void"
+			if respType == "void" {
+				tsMessage = "() => void"
+			}
+			g.declare(className, fmt.Sprintf("%s(%sonMessage: %s, onEnd?: (error: Error | null) => void): () => void;",
+				name, tsParams, tsMessage))
+			g.P(" * @param {", onMessage, "} onMessage Called with each response.")
+			g.P(" * @param {function(Error)=} opt_onEnd Called when the stream ends, with the")
+			g.P(" *     error ending it or null.")
+			g.P(" * @return {function()} The function cancelling the stream.")
+			g.P(" */")
+			g.P(className, ".prototype.", name, " = function(", strings.TrimPrefix(param+", ", ", "), "onMessage, opt_onEnd) {")
+			g.In()
+			g.P("return this.transport_.stream('", fullName, "', '", method.GetName(), "', ", data, ", ", codec, ", function(data) {")
+			g.In()
+			g.P("onMessage(", resp, ");")
+			g.Out()
+			g.P("}, opt_onEnd || function() {});")
+			g.Out()
+			g.P("};")
+			g.P()
+			continue
+		}
+
+		g.declare(className, fmt.Sprintf("%s(%s): Promise<%s>;", name, tsParams, tsType(respType)))
+		g.P(" * @return {!Promise.<", respType, ">} The response.")
+		g.P(" */")
+		g.P(className, ".prototype.", name, " = function(", param, ") {")
+		g.In()
+		call := fmt.Sprintf("this.transport_.call('%s', '%s', %s, %s)", fullName, method.GetName(), data, codec)
+		switch resp {
+		case "":
+			g.P("return ", call, ".then(function() {});")
+		case "data":
+			g.P("return ", call, ";")
+		default:
+			g.P("return ", call, ".then(function(data) {")
+			g.In()
+			g.P("return ", resp, ";")
+			g.Out()
+			g.P("});")
+		}
+		g.Out()
+		g.P("};")
+		g.P()
+	}
+}
+
+// orNull returns the expression, or null if it is empty.
+func orNull(expr string) string {
+	if expr == "" {
+		return "null"
+	}
+	return expr
+}
+
+// rpcType returns the JavaScript type of the request or response of an RPC
+// of the given message type, and the generated class wrapping its JSON data.
+// google.protobuf.Empty is void, without a class: the requests are omitted
+// and the responses resolve with undefined. The other well-known types are
+// passed in their JSON forms, without a class either.
+func (g *Generator) rpcType(typeName string) (typ, class string) {
+	name := strings.TrimPrefix(typeName, ".")
+	if name == "google.protobuf.Empty" {
+		return "void", ""
+	}
+	if _, ok := wellKnownTypes[name]; ok {
+		return "*", ""
+	}
+	class = g.jsName(g.objectNamed(typeName))
+	return "!" + class, class
+}
+
+// httpTransport returns the expression referring to the helper creating the
+// JSON-over-HTTP transport, using goog.net.XhrIo or, in ES modules, fetch.
+func (g *Generator) httpTransport() string {
+	if g.Target == targetESM {
+		return g.helper("fetchTransport")
+	}
+	return g.helper("xhrTransport")
+}
+
+// The helper functions of the service clients.
+var transportHelpers = map[string]jsHelper{
+	"xhrTransport": {
+		doc: `Creates the transport POSTing the JSON data of the requests to a
+JSON-over-HTTP gateway, at the base URL followed by "/<service>/<method>".
+The gateway can't stream the responses.
+@param {string} baseUrl The base URL of the gateway.
+@return {!` + transportType + `} The transport.`,
+		params: "baseUrl",
+		body: `return {
+	call: function(service, method, data) {
+		return new Promise(function(resolve, reject) {
+			$(goog.net.XhrIo).send(baseUrl + '/' + service + '/' + method, function(e) {
+				var xhr = e.target;
+				if (xhr.isSuccess()) {
+					resolve(xhr.getResponseJson());
+				} else {
+					reject(new Error(service + '/' + method + ': HTTP status ' + xhr.getStatus()));
+				}
+			}, 'POST', JSON.stringify(data), {'Content-Type': 'application/json'});
+		});
+	},
+	stream: $(unsupportedStream)
+};`,
+	},
+	"fetchTransport": {
+		doc: `Creates the transport POSTing the JSON data of the requests to a
+JSON-over-HTTP gateway, at the base URL followed by "/<service>/<method>".
+The gateway can't stream the responses.
+@param {string} baseUrl The base URL of the gateway.
+@return {!` + transportType + `} The transport.`,
+		params: "baseUrl",
+		body: `return {
+	call: function(service, method, data) {
+		return fetch(baseUrl + '/' + service + '/' + method, {
+			method: 'POST',
+			headers: {'Content-Type': 'application/json'},
+			body: JSON.stringify(data)
+		}).then(function(response) {
+			if (!response.ok) {
+				throw new Error(service + '/' + method + ': HTTP status ' + response.status);
+			}
+			return response.json();
+		});
+	},
+	stream: $(unsupportedStream)
+};`,
+	},
+	"unsupportedStream": {
+		doc: `Ends with an error the streams of the transports not supporting
+server-streaming RPCs.
+@param {string} service The full name of the service.
+@param {string} method The name of the method.
+@param {!Object} data The JSON data of the request.
+@param {?Object} codec The binary codec of the messages.
+@param {function(*)} onData Called with the JSON data of each response.
+@param {function(Error)} onEnd Called when the stream ends.
+@return {function()} The function cancelling the stream.`,
+		params: "service, method, data, codec, onData, onEnd",
+		body: `onEnd(new Error(service + '/' + method + ': the transport doesn\'t support streaming'));
+return function() {};`,
+	},
+	"messageCodec": {
+		doc: `Creates the codec converting the JSON data of the requests and the
+responses of a method to and from the binary wire format. A null class stands
+for google.protobuf.Empty.
+@param {?Function} requestClass The class of the requests.
+@param {?Function} responseClass The class of the responses.
+@return {{serialize: function(!Object): !Uint8Array, deserialize: function(!Uint8Array): !Object}}
+    The codec.`,
+		params: "requestClass, responseClass",
+		body: `return {
+	serialize: function(data) {
+		return requestClass ? new requestClass(data).serializeBinary() : new Uint8Array(0);
+	},
+	deserialize: function(bytes) {
+		return responseClass ? responseClass.deserializeBinary(bytes).getJsonData() : {};
+	}
+};`,
+	},
+	"grpcWebTransport": {
+		doc: `Creates the transport sending the requests with the grpc-web
+protocol, in the binary format or, with text set, the base64 text format, to
+the base URL followed by "/<service>/<method>". It uses fetch and, to cancel
+the streams, AbortController. The failed RPCs end with errors carrying the
+gRPC status code in code.
+@param {string} baseUrl The base URL of the server.
+@param {boolean=} opt_text Whether to use the text format.
+@return {!` + transportType + `} The transport.`,
+		params: "baseUrl, opt_text",
+		body: `var contentType = opt_text ? 'application/grpc-web-text' : 'application/grpc-web+proto';
+var transport = {
+	call: function(service, method, data, codec) {
+		return new Promise(function(resolve, reject) {
+			var responses = [];
+			transport.stream(service, method, data, codec || null, function(data) {
+				responses.push(data);
+			}, function(error) {
+				if (error) {
+					reject(error);
+				} else if (responses.length != 1) {
+					reject($(grpcWebError)(13, 'expected 1 response, got ' + responses.length));
+				} else {
+					resolve(responses[0]);
+				}
+			});
+		});
+	},
+	stream: function(service, method, data, codec, onData, onEnd) {
+		var ended = false;
+		var end = function(error) {
+			if (!ended) {
+				ended = true;
+				onEnd(error);
+			}
+		};
+		if (!codec) {
+			end($(grpcWebError)(12, service + '/' + method + ' has no binary serialization'));
+			return function() {};
+		}
+		var controller = typeof AbortController != 'undefined' ? new AbortController() : null;
+		var frame = $(grpcWebFrame)(codec.serialize(data));
+		fetch(baseUrl + '/' + service + '/' + method, {
+			method: 'POST',
+			headers: {'Content-Type': contentType, 'Accept': contentType, 'X-Grpc-Web': '1'},
+			body: opt_text ? $(bytesToBase64)(frame) : frame,
+			signal: controller ? controller.signal : undefined
+		}).then(function(response) {
+			if (response.headers.get('grpc-status') != null) {
+				// A trailers-only response.
+				end($(grpcWebStatus)(response.headers.get('grpc-status'), response.headers.get('grpc-message')));
+				return;
+			}
+			if (!response.ok) {
+				end($(grpcWebError)($(grpcWebHttpStatus)(response.status), 'HTTP status ' + response.status));
+				return;
+			}
+			var parse = $(grpcWebParser)(!!opt_text, function(bytes) {
+				if (!ended) {
+					onData(codec.deserialize(bytes));
+				}
+			}, function(trailers) {
+				end($(grpcWebStatus)(trailers['grpc-status'], trailers['grpc-message']));
+			});
+			var reader = response.body.getReader();
+			var pump = function() {
+				return reader.read().then(function(chunk) {
+					if (chunk.done) {
+						end($(grpcWebError)(13, 'missing trailers'));
+					} else if (!ended) {
+						parse(chunk.value);
+						return pump();
+					}
+				});
+			};
+			return pump();
+		}).catch(function(error) {
+			end($(grpcWebError)(14, String(error && error.message || error)));
+		});
+		return function() {
+			end($(grpcWebError)(1, 'cancelled'));
+			if (controller) {
+				controller.abort();
+			}
+		};
+	}
+};
+return transport;`,
+	},
+	"grpcWebFrame": {
+		doc: `Frames a message in the grpc-web protocol, prefixing the flag byte, 0
+for messages, and the length of the message as a 4-byte big-endian integer.
+@param {!Uint8Array} bytes The serialized message.
+@return {!Uint8Array} The frame.`,
+		params: "bytes",
+		body: `var frame = new Uint8Array(5 + bytes.length);
+new DataView(frame.buffer).setUint32(1, bytes.length);
+frame.set(bytes, 5);
+return frame;`,
+	},
+	"grpcWebParser": {
+		doc: `Creates the parser of the frames of a grpc-web response, given its
+body in chunks. It passes the messages to onMessage and the trailers, the frame
+with the 0x80 flag, to onTrailers. In the text format, the body is base64,
+possibly in several padded parts.
+@param {boolean} text Whether the body is in the text format.
+@param {function(!Uint8Array)} onMessage Called with each message.
+@param {function(!Object.<string, string>)} onTrailers Called with the trailers.
+@return {function(!Uint8Array)} The function parsing the next chunk.`,
+		params: "text, onMessage, onTrailers",
+		body: `var buffer = new Uint8Array(0);
+var pending = '';
+return function(chunk) {
+	if (text) {
+		// Decode the complete groups of 4 characters, part by part.
+		pending += new TextDecoder().decode(chunk);
+		var n = pending.length - pending.length % 4;
+		var parts = pending.substring(0, n).match(/[^=]+=*|=+/g) || [];
+		pending = pending.substring(n);
+		chunk = new Uint8Array(0);
+		for (var i = 0; i < parts.length; i++) {
+			chunk = $(concatBytes)(chunk, $(base64ToBytes)(parts[i]));
+		}
+	}
+	buffer = $(concatBytes)(buffer, chunk);
+	while (buffer.length >= 5) {
+		var length = new DataView(buffer.buffer, buffer.byteOffset).getUint32(1);
+		if (buffer.length < 5 + length) {
+			break;
+		}
+		var payload = buffer.subarray(5, 5 + length);
+		if (buffer[0] & 0x80) {
+			var trailers = {};
+			new TextDecoder().decode(payload).split('\r\n').forEach(function(line) {
+				var colon = line.indexOf(':');
+				if (colon > 0) {
+					trailers[line.substring(0, colon).trim().toLowerCase()] = line.substring(colon + 1).trim();
+				}
+			});
+			onTrailers(trailers);
+		} else {
+			onMessage(payload);
+		}
+		buffer = buffer.subarray(5 + length);
+	}
+};`,
+	},
+	"concatBytes": {
+		doc: `Concatenates two byte arrays.
+@param {!Uint8Array} a The first bytes.
+@param {!Uint8Array} b The second bytes.
+@return {!Uint8Array} The concatenation.`,
+		params: "a, b",
+		body: `var bytes = new Uint8Array(a.length + b.length);
+bytes.set(a);
+bytes.set(b, a.length);
+return bytes;`,
+	},
+	"grpcWebStatus": {
+		doc: `Converts the grpc-status and grpc-message of a grpc-web response
+into its error, or null for OK.
+@param {?string|undefined} status The status code.
+@param {?string|undefined} message The percent-encoded status message.
+@return {Error} The error, or null.`,
+		params: "status, message",
+		body: `if (status == null) {
+	return $(grpcWebError)(13, 'missing grpc-status');
+}
+var code = Number(status);
+if (code === 0) {
+	return null;
+}
+return $(grpcWebError)(isNaN(code) ? 2 : code, message ? decodeURIComponent(message) : '');`,
+	},
+	"grpcWebHttpStatus": {
+		doc: `Maps the HTTP status of a failed grpc-web response without
+grpc-status to the gRPC status code.
+@param {number} status The HTTP status.
+@return {number} The gRPC status code.`,
+		params: "status",
+		body: `switch (status) {
+	case 400:
+		return 13;
+	case 401:
+		return 16;
+	case 403:
+		return 7;
+	case 404:
+		return 12;
+	case 429:
+	case 502:
+	case 503:
+	case 504:
+		return 14;
+}
+return 2;`,
+	},
+	"grpcWebError": {
+		doc: `Creates the error of a failed RPC, with the gRPC status code in code
+and its name, like NOT_FOUND, prefixed to the message.
+@param {number} code The gRPC status code.
+@param {string} message The status message.
+@return {!Error} The error.`,
+		params: "code, message",
+		body: `var names = ['OK', 'CANCELLED', 'UNKNOWN', 'INVALID_ARGUMENT', 'DEADLINE_EXCEEDED',
+	'NOT_FOUND', 'ALREADY_EXISTS', 'PERMISSION_DENIED', 'RESOURCE_EXHAUSTED',
+	'FAILED_PRECONDITION', 'ABORTED', 'OUT_OF_RANGE', 'UNIMPLEMENTED', 'INTERNAL',
+	'UNAVAILABLE', 'DATA_LOSS', 'UNAUTHENTICATED'];
+var error = new Error((names[code] || 'UNKNOWN') + ': ' + message);
+error.code = code;
+return error;`,
+	},
+}
@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-js.
+// source: test/aliases.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.aliases.Sign');
+goog.provide('test.aliases.SignUtil');
+goog.provide('test.aliases.Reading');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @enum {number}
+ */
+test.aliases.Sign = {
+	NEGATIVE: -1,
+	ZERO: 0,
+	NONE: 0,
+	POSITIVE: 1
+};
+
+/**
+ * The helpers of the test.aliases.Sign enum.
+ * @const
+ */
+test.aliases.SignUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		'-1': 'NEGATIVE',
+		0: 'ZERO',
+		1: 'POSITIVE'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return test.aliases.SignUtil.isValid(value) ? test.aliases.SignUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?test.aliases.Sign} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(test.aliases.Sign, name) ? test.aliases.Sign[name] : null;
+	},
+	/**
+	 * @return {!Array.<test.aliases.Sign>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [-1, 0, 1];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(test.aliases.SignUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.aliases.Reading = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.aliases.Reading.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.aliases.Reading.TYPE_NAME = 'test.aliases.Reading';
+
+/**
+ * @return {test.aliases.Sign}
+ */
+test.aliases.Reading.prototype.getSign = function() {
+	var v = this.jsonData_["sign"];
+	return v != null ? test.aliases.enumFromJSON_(test.aliases.SignUtil, v, test.aliases.Sign.NEGATIVE) : test.aliases.Sign.NEGATIVE;
+};
+
+/**
+ * @param {test.aliases.Sign} sign The sign.
+ */
+test.aliases.Reading.prototype.setSign = function(sign) {
+	this.jsonData_["sign"] = (test.aliases.SignUtil.nameOf(sign) || sign);
+};
+
+/**
+ * @return {boolean} Whether the sign is set.
+ */
+test.aliases.Reading.prototype.hasSign = function() {
+	return this.jsonData_["sign"] != null;
+};
+
+/**
+ * Clears the sign.
+ */
+test.aliases.Reading.prototype.clearSign = function() {
+	delete this.jsonData_["sign"];
+};
+
+/**
+ * @return {Array.<number>}
+ */
+test.aliases.Reading.prototype.getHistory = function() {
+	return (this.jsonData_["history"] || []).map(function(__v) {
+		return test.aliases.enumFromJSON_(test.aliases.SignUtil, __v, test.aliases.Sign.NEGATIVE);
+	});
+};
+
+/**
+ * @param {Array.<number>} history The history.
+ */
+test.aliases.Reading.prototype.setHistory = function(history) {
+	this.jsonData_["history"] = history.map(function(__v) {
+		return (test.aliases.SignUtil.nameOf(__v) || __v);
+	});
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.aliases.Reading.prototype.equals = function(other) {
+	if (!(other instanceof test.aliases.Reading)) {
+		return false;
+	}
+	if (this.getSign() !== other.getSign()) {
+		return false;
+	}
+	if (!test.aliases.arrayEquals_(this.getHistory(), other.getHistory(), null)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.aliases.Reading.prototype.deepCopy = function() {
+	return test.aliases.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.aliases.Reading} A copy of the message, sharing no data with it.
+ */
+test.aliases.Reading.prototype.clone = function() {
+	return new test.aliases.Reading(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.aliases.Reading} other The other message.
+ */
+test.aliases.Reading.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["sign"];
+	if (other.hasSign()) {
+		this.jsonData_["sign"] = v;
+	}
+	v = other.jsonData_["history"];
+	if (v != null && v.length) {
+		this.jsonData_["history"] = (this.jsonData_["history"] || []).concat(v);
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.aliases.Reading.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.aliases.fieldMaskPaths_(paths, "sign");
+	if (p !== true) {
+		delete this.jsonData_["sign"];
+	}
+	p = test.aliases.fieldMaskPaths_(paths, "history");
+	if (p !== true) {
+		delete this.jsonData_["history"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.aliases.Reading} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.aliases.Reading.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasSign() !== other.hasSign() || this.getSign() !== other.getSign()) {
+		paths.push("sign");
+	}
+	if (!test.aliases.arrayEquals_(this.getHistory(), other.getHistory(), null)) {
+		paths.push("history");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.aliases.Reading.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.aliases.Reading.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.aliases.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.aliases.verifyValue_(errors, json["sign"], prefix + "sign", test.aliases.verifyEnum_(test.aliases.SignUtil, true, true));
+	test.aliases.verifyArray_(errors, json["history"], prefix + "history", test.aliases.verifyEnum_(test.aliases.SignUtil, true, true));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.aliases.Reading.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.aliases.Reading.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.aliases.Reading} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.aliases.Reading.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["sign"];
+	if (v != null) {
+		writer.writeEnum(1, test.aliases.enumFromJSON_(test.aliases.SignUtil, v, test.aliases.Sign.NEGATIVE));
+	}
+	v = message.jsonData_["history"];
+	if (v != null) {
+		v = v.map(function(__v) {
+			return test.aliases.enumFromJSON_(test.aliases.SignUtil, __v, test.aliases.Sign.NEGATIVE);
+		});
+		writer.writeRepeatedEnum(2, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.aliases.Reading} The message.
+ */
+test.aliases.Reading.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.aliases.Reading.deserializeBinaryFromReader(new test.aliases.Reading({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.aliases.Reading} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.aliases.Reading} The message.
+ */
+test.aliases.Reading.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readEnum();
+			message.jsonData_["sign"] = (test.aliases.SignUtil.nameOf(value) || value);
+			break;
+		case 2:
+			value = reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()];
+			value = value.map(function(__v) {
+				return (test.aliases.SignUtil.nameOf(__v) || __v);
+			});
+			message.jsonData_["history"] = (message.jsonData_["history"] || []).concat(value);
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.aliases.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.aliases.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.aliases.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.aliases.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Converts an enum value from its canonical JSON form, the name of the value
+ * or its number, maybe as a decimal string, into its number.
+ * @param {{valueOf: function(string): ?number}} util The helpers of the enum.
+ * @param {*} v The JSON value.
+ * @param {number} def The default of the field, for the names the enum doesn't
+ *     define.
+ * @return {number} The number.
+ */
+test.aliases.enumFromJSON_ = function(util, v, def) {
+	if (typeof v !== 'string') {
+		return /** @type {number} */ (v);
+	}
+	if (/^-?[0-9]+$/.test(v)) {
+		return Number(v);
+	}
+	var n = util.valueOf(v);
+	return n !== null ? n : def;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.aliases.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.aliases.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.aliases.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.aliases.verifyEnum_ = function(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[test.aliases.verifyError_(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [test.aliases.verifyError_(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [test.aliases.verifyError_(path, 'a value of the enum', v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.aliases.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.aliases.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
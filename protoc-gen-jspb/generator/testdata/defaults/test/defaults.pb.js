@@ -0,0 +1,989 @@
+// Code generated by protoc-gen-js.
+// source: test/defaults.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.defaults.Mode');
+goog.provide('test.defaults.ModeUtil');
+goog.provide('test.defaults.Settings');
+
+goog.require('goog.crypt.base64');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @enum {number}
+ */
+test.defaults.Mode = {
+	FAST: 1,
+	SLOW: 2
+};
+
+/**
+ * The helpers of the test.defaults.Mode enum.
+ * @const
+ */
+test.defaults.ModeUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		1: 'FAST',
+		2: 'SLOW'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return test.defaults.ModeUtil.isValid(value) ? test.defaults.ModeUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?test.defaults.Mode} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(test.defaults.Mode, name) ? test.defaults.Mode[name] : null;
+	},
+	/**
+	 * @return {!Array.<test.defaults.Mode>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [1, 2];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(test.defaults.ModeUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.defaults.Settings = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.defaults.Settings.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.defaults.Settings.TYPE_NAME = 'test.defaults.Settings';
+
+/**
+ * The default value of the retries field.
+ * @const {number}
+ */
+test.defaults.Settings.DEFAULT_RETRIES = 3;
+
+/**
+ * The default value of the timeout field.
+ * @const {number}
+ */
+test.defaults.Settings.DEFAULT_TIMEOUT = -1;
+
+/**
+ * The default value of the enabled field.
+ * @const {boolean}
+ */
+test.defaults.Settings.DEFAULT_ENABLED = true;
+
+/**
+ * The default value of the ratio field.
+ * @const {number}
+ */
+test.defaults.Settings.DEFAULT_RATIO = Infinity;
+
+/**
+ * The default value of the epsilon field.
+ * @const {number}
+ */
+test.defaults.Settings.DEFAULT_EPSILON = NaN;
+
+/**
+ * The default value of the label field.
+ * @const {string}
+ */
+test.defaults.Settings.DEFAULT_LABEL = "a \"quoted\" label";
+
+/**
+ * The default value of the magic field.
+ * @const {string}
+ */
+test.defaults.Settings.DEFAULT_MAGIC = "AQJhYmM=";
+
+/**
+ * The default value of the mode field.
+ * @const {test.defaults.Mode}
+ */
+test.defaults.Settings.DEFAULT_MODE = test.defaults.Mode.SLOW;
+
+/**
+ * The default value of the banner field.
+ * @const {string}
+ */
+test.defaults.Settings.DEFAULT_BANNER = "bell\u0007, smile 😀, line\u2028break\ttab";
+
+/**
+ * @return {number}
+ */
+test.defaults.Settings.prototype.getRetries = function() {
+	var v = this.jsonData_["retries"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_RETRIES;
+};
+
+/**
+ * @param {number} retries The retries.
+ */
+test.defaults.Settings.prototype.setRetries = function(retries) {
+	this.jsonData_["retries"] = retries;
+};
+
+/**
+ * @return {boolean} Whether the retries is set.
+ */
+test.defaults.Settings.prototype.hasRetries = function() {
+	return this.jsonData_["retries"] != null;
+};
+
+/**
+ * Clears the retries.
+ */
+test.defaults.Settings.prototype.clearRetries = function() {
+	delete this.jsonData_["retries"];
+};
+
+/**
+ * @return {number}
+ */
+test.defaults.Settings.prototype.getTimeout = function() {
+	var v = this.jsonData_["timeout"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_TIMEOUT;
+};
+
+/**
+ * @param {number} timeout The timeout.
+ */
+test.defaults.Settings.prototype.setTimeout = function(timeout) {
+	this.jsonData_["timeout"] = timeout;
+};
+
+/**
+ * @return {boolean} Whether the timeout is set.
+ */
+test.defaults.Settings.prototype.hasTimeout = function() {
+	return this.jsonData_["timeout"] != null;
+};
+
+/**
+ * Clears the timeout.
+ */
+test.defaults.Settings.prototype.clearTimeout = function() {
+	delete this.jsonData_["timeout"];
+};
+
+/**
+ * @return {boolean}
+ */
+test.defaults.Settings.prototype.getEnabled = function() {
+	var v = this.jsonData_["enabled"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_ENABLED;
+};
+
+/**
+ * @param {boolean} enabled The enabled.
+ */
+test.defaults.Settings.prototype.setEnabled = function(enabled) {
+	this.jsonData_["enabled"] = enabled;
+};
+
+/**
+ * @return {boolean} Whether the enabled is set.
+ */
+test.defaults.Settings.prototype.hasEnabled = function() {
+	return this.jsonData_["enabled"] != null;
+};
+
+/**
+ * Clears the enabled.
+ */
+test.defaults.Settings.prototype.clearEnabled = function() {
+	delete this.jsonData_["enabled"];
+};
+
+/**
+ * @return {number}
+ */
+test.defaults.Settings.prototype.getRatio = function() {
+	var v = this.jsonData_["ratio"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_RATIO;
+};
+
+/**
+ * @param {number} ratio The ratio.
+ */
+test.defaults.Settings.prototype.setRatio = function(ratio) {
+	this.jsonData_["ratio"] = ratio;
+};
+
+/**
+ * @return {boolean} Whether the ratio is set.
+ */
+test.defaults.Settings.prototype.hasRatio = function() {
+	return this.jsonData_["ratio"] != null;
+};
+
+/**
+ * Clears the ratio.
+ */
+test.defaults.Settings.prototype.clearRatio = function() {
+	delete this.jsonData_["ratio"];
+};
+
+/**
+ * @return {number}
+ */
+test.defaults.Settings.prototype.getEpsilon = function() {
+	var v = this.jsonData_["epsilon"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_EPSILON;
+};
+
+/**
+ * @param {number} epsilon The epsilon.
+ */
+test.defaults.Settings.prototype.setEpsilon = function(epsilon) {
+	this.jsonData_["epsilon"] = epsilon;
+};
+
+/**
+ * @return {boolean} Whether the epsilon is set.
+ */
+test.defaults.Settings.prototype.hasEpsilon = function() {
+	return this.jsonData_["epsilon"] != null;
+};
+
+/**
+ * Clears the epsilon.
+ */
+test.defaults.Settings.prototype.clearEpsilon = function() {
+	delete this.jsonData_["epsilon"];
+};
+
+/**
+ * @return {string}
+ */
+test.defaults.Settings.prototype.getLabel = function() {
+	var v = this.jsonData_["label"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_LABEL;
+};
+
+/**
+ * @param {string} label The label.
+ */
+test.defaults.Settings.prototype.setLabel = function(label) {
+	this.jsonData_["label"] = label;
+};
+
+/**
+ * @return {boolean} Whether the label is set.
+ */
+test.defaults.Settings.prototype.hasLabel = function() {
+	return this.jsonData_["label"] != null;
+};
+
+/**
+ * Clears the label.
+ */
+test.defaults.Settings.prototype.clearLabel = function() {
+	delete this.jsonData_["label"];
+};
+
+/**
+ * @return {string}
+ */
+test.defaults.Settings.prototype.getMagic = function() {
+	var v = this.jsonData_["magic"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_MAGIC;
+};
+
+/**
+ * @param {string} magic The magic.
+ */
+test.defaults.Settings.prototype.setMagic = function(magic) {
+	this.jsonData_["magic"] = magic;
+};
+
+/**
+ * @return {boolean} Whether the magic is set.
+ */
+test.defaults.Settings.prototype.hasMagic = function() {
+	return this.jsonData_["magic"] != null;
+};
+
+/**
+ * Clears the magic.
+ */
+test.defaults.Settings.prototype.clearMagic = function() {
+	delete this.jsonData_["magic"];
+};
+
+/**
+ * @return {test.defaults.Mode}
+ */
+test.defaults.Settings.prototype.getMode = function() {
+	var v = this.jsonData_["mode"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_MODE;
+};
+
+/**
+ * @param {test.defaults.Mode} mode The mode.
+ */
+test.defaults.Settings.prototype.setMode = function(mode) {
+	this.jsonData_["mode"] = mode;
+};
+
+/**
+ * @return {boolean} Whether the mode is set.
+ */
+test.defaults.Settings.prototype.hasMode = function() {
+	return this.jsonData_["mode"] != null;
+};
+
+/**
+ * Clears the mode.
+ */
+test.defaults.Settings.prototype.clearMode = function() {
+	delete this.jsonData_["mode"];
+};
+
+/**
+ * @return {string}
+ */
+test.defaults.Settings.prototype.getBanner = function() {
+	var v = this.jsonData_["banner"];
+	return v != null ? v : test.defaults.Settings.DEFAULT_BANNER;
+};
+
+/**
+ * @param {string} banner The banner.
+ */
+test.defaults.Settings.prototype.setBanner = function(banner) {
+	this.jsonData_["banner"] = banner;
+};
+
+/**
+ * @return {boolean} Whether the banner is set.
+ */
+test.defaults.Settings.prototype.hasBanner = function() {
+	return this.jsonData_["banner"] != null;
+};
+
+/**
+ * Clears the banner.
+ */
+test.defaults.Settings.prototype.clearBanner = function() {
+	delete this.jsonData_["banner"];
+};
+
+/**
+ * @return {test.defaults.Mode}
+ */
+test.defaults.Settings.prototype.getFallback = function() {
+	var v = this.jsonData_["fallback"];
+	return v != null ? v : test.defaults.Mode.FAST;
+};
+
+/**
+ * @param {test.defaults.Mode} fallback The fallback.
+ */
+test.defaults.Settings.prototype.setFallback = function(fallback) {
+	this.jsonData_["fallback"] = fallback;
+};
+
+/**
+ * @return {boolean} Whether the fallback is set.
+ */
+test.defaults.Settings.prototype.hasFallback = function() {
+	return this.jsonData_["fallback"] != null;
+};
+
+/**
+ * Clears the fallback.
+ */
+test.defaults.Settings.prototype.clearFallback = function() {
+	delete this.jsonData_["fallback"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.defaults.Settings.prototype.equals = function(other) {
+	if (!(other instanceof test.defaults.Settings)) {
+		return false;
+	}
+	if (this.getRetries() !== other.getRetries()) {
+		return false;
+	}
+	if (this.getTimeout() !== other.getTimeout()) {
+		return false;
+	}
+	if (this.getEnabled() !== other.getEnabled()) {
+		return false;
+	}
+	if (!test.defaults.floatEquals_(this.getRatio(), other.getRatio())) {
+		return false;
+	}
+	if (!test.defaults.floatEquals_(this.getEpsilon(), other.getEpsilon())) {
+		return false;
+	}
+	if (this.getLabel() !== other.getLabel()) {
+		return false;
+	}
+	if (this.getMagic() !== other.getMagic()) {
+		return false;
+	}
+	if (this.getMode() !== other.getMode()) {
+		return false;
+	}
+	if (this.getBanner() !== other.getBanner()) {
+		return false;
+	}
+	if (this.getFallback() !== other.getFallback()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.defaults.Settings.prototype.deepCopy = function() {
+	return test.defaults.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.defaults.Settings} A copy of the message, sharing no data with it.
+ */
+test.defaults.Settings.prototype.clone = function() {
+	return new test.defaults.Settings(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.defaults.Settings} other The other message.
+ */
+test.defaults.Settings.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["retries"];
+	if (other.hasRetries()) {
+		this.jsonData_["retries"] = v;
+	}
+	v = other.jsonData_["timeout"];
+	if (other.hasTimeout()) {
+		this.jsonData_["timeout"] = v;
+	}
+	v = other.jsonData_["enabled"];
+	if (other.hasEnabled()) {
+		this.jsonData_["enabled"] = v;
+	}
+	v = other.jsonData_["ratio"];
+	if (other.hasRatio()) {
+		this.jsonData_["ratio"] = v;
+	}
+	v = other.jsonData_["epsilon"];
+	if (other.hasEpsilon()) {
+		this.jsonData_["epsilon"] = v;
+	}
+	v = other.jsonData_["label"];
+	if (other.hasLabel()) {
+		this.jsonData_["label"] = v;
+	}
+	v = other.jsonData_["magic"];
+	if (other.hasMagic()) {
+		this.jsonData_["magic"] = v;
+	}
+	v = other.jsonData_["mode"];
+	if (other.hasMode()) {
+		this.jsonData_["mode"] = v;
+	}
+	v = other.jsonData_["banner"];
+	if (other.hasBanner()) {
+		this.jsonData_["banner"] = v;
+	}
+	v = other.jsonData_["fallback"];
+	if (other.hasFallback()) {
+		this.jsonData_["fallback"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.defaults.Settings.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.defaults.fieldMaskPaths_(paths, "retries");
+	if (p !== true) {
+		delete this.jsonData_["retries"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "timeout");
+	if (p !== true) {
+		delete this.jsonData_["timeout"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "enabled");
+	if (p !== true) {
+		delete this.jsonData_["enabled"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "ratio");
+	if (p !== true) {
+		delete this.jsonData_["ratio"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "epsilon");
+	if (p !== true) {
+		delete this.jsonData_["epsilon"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "label");
+	if (p !== true) {
+		delete this.jsonData_["label"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "magic");
+	if (p !== true) {
+		delete this.jsonData_["magic"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "mode");
+	if (p !== true) {
+		delete this.jsonData_["mode"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "banner");
+	if (p !== true) {
+		delete this.jsonData_["banner"];
+	}
+	p = test.defaults.fieldMaskPaths_(paths, "fallback");
+	if (p !== true) {
+		delete this.jsonData_["fallback"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.defaults.Settings} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.defaults.Settings.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasRetries() !== other.hasRetries() || this.getRetries() !== other.getRetries()) {
+		paths.push("retries");
+	}
+	if (this.hasTimeout() !== other.hasTimeout() || this.getTimeout() !== other.getTimeout()) {
+		paths.push("timeout");
+	}
+	if (this.hasEnabled() !== other.hasEnabled() || this.getEnabled() !== other.getEnabled()) {
+		paths.push("enabled");
+	}
+	if (this.hasRatio() !== other.hasRatio() || !test.defaults.floatEquals_(this.getRatio(), other.getRatio())) {
+		paths.push("ratio");
+	}
+	if (this.hasEpsilon() !== other.hasEpsilon() || !test.defaults.floatEquals_(this.getEpsilon(), other.getEpsilon())) {
+		paths.push("epsilon");
+	}
+	if (this.hasLabel() !== other.hasLabel() || this.getLabel() !== other.getLabel()) {
+		paths.push("label");
+	}
+	if (this.hasMagic() !== other.hasMagic() || this.getMagic() !== other.getMagic()) {
+		paths.push("magic");
+	}
+	if (this.hasMode() !== other.hasMode() || this.getMode() !== other.getMode()) {
+		paths.push("mode");
+	}
+	if (this.hasBanner() !== other.hasBanner() || this.getBanner() !== other.getBanner()) {
+		paths.push("banner");
+	}
+	if (this.hasFallback() !== other.hasFallback() || this.getFallback() !== other.getFallback()) {
+		paths.push("fallback");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.defaults.Settings.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.defaults.Settings.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.defaults.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.defaults.verifyValue_(errors, json["retries"], prefix + "retries", test.defaults.verifyInteger_(32, false, false));
+	test.defaults.verifyValue_(errors, json["timeout"], prefix + "timeout", test.defaults.verifyInteger_(64, false, false));
+	test.defaults.verifyValue_(errors, json["enabled"], prefix + "enabled", test.defaults.verifyBool_);
+	test.defaults.verifyValue_(errors, json["ratio"], prefix + "ratio", test.defaults.verifyNumber_);
+	test.defaults.verifyValue_(errors, json["epsilon"], prefix + "epsilon", test.defaults.verifyNumber_);
+	test.defaults.verifyValue_(errors, json["label"], prefix + "label", test.defaults.verifyString_);
+	test.defaults.verifyValue_(errors, json["magic"], prefix + "magic", test.defaults.verifyString_);
+	test.defaults.verifyValue_(errors, json["mode"], prefix + "mode", test.defaults.verifyEnum_(test.defaults.ModeUtil, false, true));
+	test.defaults.verifyValue_(errors, json["banner"], prefix + "banner", test.defaults.verifyString_);
+	test.defaults.verifyValue_(errors, json["fallback"], prefix + "fallback", test.defaults.verifyEnum_(test.defaults.ModeUtil, false, true));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.defaults.Settings.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.defaults.Settings.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.defaults.Settings} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.defaults.Settings.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["retries"];
+	if (v != null) {
+		writer.writeInt32(1, v);
+	}
+	v = message.jsonData_["timeout"];
+	if (v != null) {
+		writer.writeInt64(2, v);
+	}
+	v = message.jsonData_["enabled"];
+	if (v != null) {
+		writer.writeBool(3, v);
+	}
+	v = message.jsonData_["ratio"];
+	if (v != null) {
+		writer.writeDouble(4, v);
+	}
+	v = message.jsonData_["epsilon"];
+	if (v != null) {
+		writer.writeFloat(5, v);
+	}
+	v = message.jsonData_["label"];
+	if (v != null) {
+		writer.writeString(6, v);
+	}
+	v = message.jsonData_["magic"];
+	if (v != null) {
+		writer.writeBytes(7, v);
+	}
+	v = message.jsonData_["mode"];
+	if (v != null) {
+		writer.writeEnum(8, v);
+	}
+	v = message.jsonData_["banner"];
+	if (v != null) {
+		writer.writeString(10, v);
+	}
+	v = message.jsonData_["fallback"];
+	if (v != null) {
+		writer.writeEnum(9, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.defaults.Settings} The message.
+ */
+test.defaults.Settings.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.defaults.Settings.deserializeBinaryFromReader(new test.defaults.Settings({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.defaults.Settings} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.defaults.Settings} The message.
+ */
+test.defaults.Settings.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readInt32();
+			message.jsonData_["retries"] = value;
+			break;
+		case 2:
+			value = reader.readInt64();
+			message.jsonData_["timeout"] = value;
+			break;
+		case 3:
+			value = reader.readBool();
+			message.jsonData_["enabled"] = value;
+			break;
+		case 4:
+			value = reader.readDouble();
+			message.jsonData_["ratio"] = value;
+			break;
+		case 5:
+			value = reader.readFloat();
+			message.jsonData_["epsilon"] = value;
+			break;
+		case 6:
+			value = reader.readString();
+			message.jsonData_["label"] = value;
+			break;
+		case 7:
+			value = goog.crypt.base64.encodeByteArray(reader.readBytes());
+			message.jsonData_["magic"] = value;
+			break;
+		case 8:
+			value = reader.readEnum();
+			message.jsonData_["mode"] = value;
+			break;
+		case 10:
+			value = reader.readString();
+			message.jsonData_["banner"] = value;
+			break;
+		case 9:
+			value = reader.readEnum();
+			message.jsonData_["fallback"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.defaults.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.defaults.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.defaults.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.defaults.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two floating point numbers, NaN being equal to NaN.
+ * @param {?number} a The first number.
+ * @param {?number} b The second number.
+ * @return {boolean} Whether the numbers are equal.
+ */
+test.defaults.floatEquals_ = function(a, b) {
+	return a === b || (a !== a && b !== b);
+};
+
+/**
+ * Checks that a JSON value is a boolean.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.defaults.verifyBool_ = function(v, path) {
+	return typeof v === 'boolean' ? [] : [test.defaults.verifyError_(path, 'a boolean', v)];
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.defaults.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.defaults.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.defaults.verifyEnum_ = function(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[test.defaults.verifyError_(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [test.defaults.verifyError_(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [test.defaults.verifyError_(path, 'a value of the enum', v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.defaults.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.defaults.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.defaults.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.defaults.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks that a JSON value is a number.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.defaults.verifyNumber_ = function(v, path) {
+	return typeof v === 'number' ? [] : [test.defaults.verifyError_(path, 'a number', v)];
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.defaults.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.defaults.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.defaults.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
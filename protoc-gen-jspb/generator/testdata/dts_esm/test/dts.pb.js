@@ -0,0 +1,2121 @@
+// Code generated by protoc-gen-js.
+// source: test/dts.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+import * as jspb from 'google-protobuf';
+
+/**
+ * @enum {number}
+ */
+export const Level = {
+	LOW: 0,
+	HIGH: 1
+};
+
+/**
+ * The helpers of the Level enum.
+ * @const
+ */
+export const LevelUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		0: 'LOW',
+		1: 'HIGH'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return LevelUtil.isValid(value) ? LevelUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?Level} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(Level, name) ? Level[name] : null;
+	},
+	/**
+	 * @return {!Array.<Level>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [0, 1];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(LevelUtil.names, value);
+	}
+};
+
+/**
+ * @enum {number}
+ */
+export const Tree_Shape = {
+	ROUND: 0,
+	TALL: 1
+};
+
+/**
+ * The helpers of the Tree_Shape enum.
+ * @const
+ */
+export const Tree_ShapeUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		0: 'ROUND',
+		1: 'TALL'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return Tree_ShapeUtil.isValid(value) ? Tree_ShapeUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?Tree_Shape} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(Tree_Shape, name) ? Tree_Shape[name] : null;
+	},
+	/**
+	 * @return {!Array.<Tree_Shape>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [0, 1];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(Tree_ShapeUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const Tree = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+Tree.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+Tree.TYPE_NAME = 'test.dts.Tree';
+
+/**
+ * @return {Tree_Leaf}
+ */
+Tree.prototype.getLeaf = function() {
+	if (this.leaf_) {
+		return this.leaf_;
+	}
+	var v = this.jsonData_["leaf"];
+	if (v) {
+		/** @private {Tree_Leaf} */
+		this.leaf_ = new Tree_Leaf(v);
+		return this.leaf_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {Tree_Leaf} leaf The leaf.
+ */
+Tree.prototype.setLeaf = function(leaf) {
+	this.jsonData_["leaf"] = leaf.getJsonData();
+	this.leaf_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the leaf is set.
+ */
+Tree.prototype.hasLeaf = function() {
+	return this.jsonData_["leaf"] != null;
+};
+
+/**
+ * Clears the leaf.
+ */
+Tree.prototype.clearLeaf = function() {
+	delete this.jsonData_["leaf"];
+	this.leaf_ = undefined;
+};
+
+/**
+ * @return {Array.<Tree_Leaf>}
+ */
+Tree.prototype.getLeaves = function() {
+	if (this.leaves_) {
+		return this.leaves_;
+	}
+	var v = this.jsonData_["leaves"];
+	if (v) {
+		/** @private {Array.<Tree_Leaf>} */
+		this.leaves_ = [];
+		v.forEach(function(__item, __index) {
+			this.leaves_.push(new Tree_Leaf(__item));
+		}, this);
+		return this.leaves_;
+	}
+	return [];
+};
+
+/**
+ * @param {Array.<Tree_Leaf>} leaves The leaves.
+ */
+Tree.prototype.setLeaves = function(leaves) {
+	this.jsonData_["leaves"] = (leaves || []).map(function(__item) {
+		return __item.getJsonData();
+	});
+	this.leaves_ = undefined;
+};
+
+/**
+ * @return {Object.<string, Tree_Leaf>}
+ */
+Tree.prototype.getByName = function() {
+	if (this.by_name_) {
+		return this.by_name_;
+	}
+	var v = this.jsonData_["by_name"];
+	if (v) {
+		/** @private {Object.<string, Tree_Leaf>} */
+		this.by_name_ = {};
+		for (var __key in v) {
+			this.by_name_[__key] = new Tree_Leaf(v[__key]);
+		}
+		return this.by_name_;
+	}
+	return {};
+};
+
+/**
+ * @param {Object.<string, Tree_Leaf>} by_name The by_name.
+ */
+Tree.prototype.setByName = function(by_name) {
+	var __data = {};
+	for (var __key in by_name) {
+		__data[__key] = by_name[__key].getJsonData();
+	}
+	this.jsonData_["by_name"] = __data;
+	this.by_name_ = undefined;
+};
+
+/**
+ * @return {!Map.<string, Tree_Leaf>}
+ */
+Tree.prototype.getByNameMap = function() {
+	var __map = new Map();
+	var __obj = this.getByName();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, Tree_Leaf>} by_name The by_name.
+ */
+Tree.prototype.setByNameMap = function(by_name) {
+	var __obj = {};
+	by_name.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setByName(__obj);
+};
+
+/**
+ * @return {Object.<string, number>}
+ */
+Tree.prototype.getCounts = function() {
+	return this.jsonData_["counts"] || {};
+};
+
+/**
+ * @param {Object.<string, number>} counts The counts.
+ */
+Tree.prototype.setCounts = function(counts) {
+	this.jsonData_["counts"] = counts;
+};
+
+/**
+ * @return {!Map.<string, number>}
+ */
+Tree.prototype.getCountsMap = function() {
+	var __map = new Map();
+	var __obj = this.getCounts();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, number>} counts The counts.
+ */
+Tree.prototype.setCountsMap = function(counts) {
+	var __obj = {};
+	counts.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setCounts(__obj);
+};
+
+/**
+ * @return {string}
+ */
+Tree.prototype.getHeight = function() {
+	var v = this.jsonData_["height"];
+	return v != null ? String(v) : '0';
+};
+
+/**
+ * @param {string} height The height.
+ */
+Tree.prototype.setHeight = function(height) {
+	this.jsonData_["height"] = height;
+};
+
+/**
+ * @return {boolean} Whether the height is set.
+ */
+Tree.prototype.hasHeight = function() {
+	return this.jsonData_["height"] != null;
+};
+
+/**
+ * Clears the height.
+ */
+Tree.prototype.clearHeight = function() {
+	delete this.jsonData_["height"];
+};
+
+/**
+ * @return {Array.<string>}
+ */
+Tree.prototype.getRings = function() {
+	return (this.jsonData_["rings"] || []).map(function(__v) {
+		return String(__v);
+	});
+};
+
+/**
+ * @param {Array.<string>} rings The rings.
+ */
+Tree.prototype.setRings = function(rings) {
+	this.jsonData_["rings"] = rings;
+};
+
+/**
+ * @return {Tree_Shape}
+ */
+Tree.prototype.getShape = function() {
+	var v = this.jsonData_["shape"];
+	return v != null ? v : Tree_Shape.ROUND;
+};
+
+/**
+ * @param {Tree_Shape} shape The shape.
+ */
+Tree.prototype.setShape = function(shape) {
+	this.jsonData_["shape"] = shape;
+};
+
+/**
+ * @return {boolean} Whether the shape is set.
+ */
+Tree.prototype.hasShape = function() {
+	return this.jsonData_["shape"] != null;
+};
+
+/**
+ * Clears the shape.
+ */
+Tree.prototype.clearShape = function() {
+	delete this.jsonData_["shape"];
+};
+
+/**
+ * @return {Level}
+ */
+Tree.prototype.getLevel = function() {
+	var v = this.jsonData_["level"];
+	return v != null ? v : Level.LOW;
+};
+
+/**
+ * @param {Level} level The level.
+ */
+Tree.prototype.setLevel = function(level) {
+	this.jsonData_["level"] = level;
+};
+
+/**
+ * @return {boolean} Whether the level is set.
+ */
+Tree.prototype.hasLevel = function() {
+	return this.jsonData_["level"] != null;
+};
+
+/**
+ * Clears the level.
+ */
+Tree.prototype.clearLevel = function() {
+	delete this.jsonData_["level"];
+};
+
+/**
+ * @return {string}
+ */
+Tree.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+Tree.prototype.setName = function(name) {
+	this.clearKind();
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set.
+ */
+Tree.prototype.hasName = function() {
+	return this.jsonData_["name"] != null;
+};
+
+/**
+ * Clears the name.
+ */
+Tree.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * @return {number}
+ */
+Tree.prototype.getNumber = function() {
+	var v = this.jsonData_["number"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} number The number.
+ */
+Tree.prototype.setNumber = function(number) {
+	this.clearKind();
+	this.jsonData_["number"] = number;
+};
+
+/**
+ * @return {boolean} Whether the number is set.
+ */
+Tree.prototype.hasNumber = function() {
+	return this.jsonData_["number"] != null;
+};
+
+/**
+ * Clears the number.
+ */
+Tree.prototype.clearNumber = function() {
+	delete this.jsonData_["number"];
+};
+
+/**
+ * @enum {number}
+ */
+Tree.KindCase = {
+	KIND_NOT_SET: 0,
+	NAME: 9,
+	NUMBER: 10
+};
+
+/**
+ * @return {Tree.KindCase} The case of the kind oneof.
+ */
+Tree.prototype.getKindCase = function() {
+	if (this.jsonData_["name"] != null) {
+		return Tree.KindCase.NAME;
+	}
+	if (this.jsonData_["number"] != null) {
+		return Tree.KindCase.NUMBER;
+	}
+	return Tree.KindCase.KIND_NOT_SET;
+};
+
+/**
+ * Clears all members of the kind oneof.
+ */
+Tree.prototype.clearKind = function() {
+	delete this.jsonData_["name"];
+	delete this.jsonData_["number"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+Tree.prototype.equals = function(other) {
+	if (!(other instanceof Tree)) {
+		return false;
+	}
+	if (!messageEquals(this.getLeaf(), other.getLeaf())) {
+		return false;
+	}
+	if (!arrayEquals(this.getLeaves(), other.getLeaves(), messageEquals)) {
+		return false;
+	}
+	if (!objectEquals(this.getByName(), other.getByName(), messageEquals)) {
+		return false;
+	}
+	if (!objectEquals(this.getCounts(), other.getCounts(), null)) {
+		return false;
+	}
+	if (this.getHeight() !== other.getHeight()) {
+		return false;
+	}
+	if (!arrayEquals(this.getRings(), other.getRings(), null)) {
+		return false;
+	}
+	if (this.getShape() !== other.getShape()) {
+		return false;
+	}
+	if (this.getLevel() !== other.getLevel()) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	if (this.getNumber() !== other.getNumber()) {
+		return false;
+	}
+	return extensionsEqual(this.jsonData_, other.jsonData_);
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+Tree.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!Tree} A copy of the message, sharing no data with it.
+ */
+Tree.prototype.clone = function() {
+	return new Tree(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!Tree} other The other message.
+ */
+Tree.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["leaf"];
+	if (other.hasLeaf()) {
+		if (this.hasLeaf()) {
+			this.getLeaf().mergeFrom(other.getLeaf());
+		} else {
+			this.jsonData_["leaf"] = copyJSON(v);
+		}
+		this.leaf_ = undefined;
+	}
+	v = other.jsonData_["leaves"];
+	if (v != null && v.length) {
+		this.jsonData_["leaves"] = (this.jsonData_["leaves"] || []).concat(copyJSON(v));
+		this.leaves_ = undefined;
+	}
+	v = other.jsonData_["by_name"];
+	if (v != null) {
+		this.jsonData_["by_name"] = this.jsonData_["by_name"] || {};
+		for (var __key in v) {
+			this.jsonData_["by_name"][__key] = copyJSON(v[__key]);
+		}
+		this.by_name_ = undefined;
+	}
+	v = other.jsonData_["counts"];
+	if (v != null) {
+		this.jsonData_["counts"] = this.jsonData_["counts"] || {};
+		for (var __key in v) {
+			this.jsonData_["counts"][__key] = copyJSON(v[__key]);
+		}
+	}
+	v = other.jsonData_["height"];
+	if (other.hasHeight()) {
+		this.jsonData_["height"] = v;
+	}
+	v = other.jsonData_["rings"];
+	if (v != null && v.length) {
+		this.jsonData_["rings"] = (this.jsonData_["rings"] || []).concat(v);
+	}
+	v = other.jsonData_["shape"];
+	if (other.hasShape()) {
+		this.jsonData_["shape"] = v;
+	}
+	v = other.jsonData_["level"];
+	if (other.hasLevel()) {
+		this.jsonData_["level"] = v;
+	}
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.clearKind();
+		this.jsonData_["name"] = v;
+	}
+	v = other.jsonData_["number"];
+	if (other.hasNumber()) {
+		this.clearKind();
+		this.jsonData_["number"] = v;
+	}
+	mergeExtensions(Tree.extensions, this.jsonData_, other.jsonData_);
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+Tree.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "leaf");
+	if (p !== true) {
+		if (p.length && this.hasLeaf()) {
+			this.getLeaf().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["leaf"];
+			this.leaf_ = undefined;
+		}
+	}
+	p = fieldMaskPaths(paths, "leaves");
+	if (p !== true) {
+		delete this.jsonData_["leaves"];
+		this.leaves_ = undefined;
+	}
+	p = fieldMaskPaths(paths, "by_name");
+	if (p !== true) {
+		delete this.jsonData_["by_name"];
+		this.by_name_ = undefined;
+	}
+	p = fieldMaskPaths(paths, "counts");
+	if (p !== true) {
+		delete this.jsonData_["counts"];
+		this.counts_ = undefined;
+	}
+	p = fieldMaskPaths(paths, "height");
+	if (p !== true) {
+		delete this.jsonData_["height"];
+	}
+	p = fieldMaskPaths(paths, "rings");
+	if (p !== true) {
+		delete this.jsonData_["rings"];
+	}
+	p = fieldMaskPaths(paths, "shape");
+	if (p !== true) {
+		delete this.jsonData_["shape"];
+	}
+	p = fieldMaskPaths(paths, "level");
+	if (p !== true) {
+		delete this.jsonData_["level"];
+	}
+	p = fieldMaskPaths(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+	p = fieldMaskPaths(paths, "number");
+	if (p !== true) {
+		delete this.jsonData_["number"];
+	}
+	pruneExtensions(this.jsonData_, paths);
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!Tree} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+Tree.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasLeaf() && other.hasLeaf()) {
+		appendPaths(paths, "leaf.", this.getLeaf().diffFieldMask(other.getLeaf()));
+	} else if (this.hasLeaf() !== other.hasLeaf()) {
+		paths.push("leaf");
+	}
+	if (!arrayEquals(this.getLeaves(), other.getLeaves(), messageEquals)) {
+		paths.push("leaves");
+	}
+	if (!objectEquals(this.getByName(), other.getByName(), messageEquals)) {
+		paths.push("by_name");
+	}
+	if (!objectEquals(this.getCounts(), other.getCounts(), null)) {
+		paths.push("counts");
+	}
+	if (this.hasHeight() !== other.hasHeight() || this.getHeight() !== other.getHeight()) {
+		paths.push("height");
+	}
+	if (!arrayEquals(this.getRings(), other.getRings(), null)) {
+		paths.push("rings");
+	}
+	if (this.hasShape() !== other.hasShape() || this.getShape() !== other.getShape()) {
+		paths.push("shape");
+	}
+	if (this.hasLevel() !== other.hasLevel() || this.getLevel() !== other.getLevel()) {
+		paths.push("level");
+	}
+	if (this.hasName() !== other.hasName() || this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	if (this.hasNumber() !== other.hasNumber() || this.getNumber() !== other.getNumber()) {
+		paths.push("number");
+	}
+	diffExtensions(paths, this.jsonData_, other.jsonData_);
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+Tree.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasLeaf()) {
+		v = this.getLeaf();
+		nestedViolations(violations, "leaf", v.validate());
+	}
+	v = this.getLeaves();
+	v.forEach(function(__item, __index) {
+		nestedViolations(violations, "leaves[" + __index + "]", __item.validate());
+	}, this);
+	v = this.getByName();
+	for (var __key in v) {
+		nestedViolations(violations, "by_name[\"" + __key + "\"]", v[__key].validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+Tree.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["leaf"], prefix + "leaf", Tree_Leaf.verify);
+	verifyArray(errors, json["leaves"], prefix + "leaves", Tree_Leaf.verify);
+	verifyMap(errors, json["by_name"], prefix + "by_name", Tree_Leaf.verify);
+	verifyMap(errors, json["counts"], prefix + "counts", verifyInteger(32, false, false));
+	verifyValue(errors, json["height"], prefix + "height", verifyDecimal(false));
+	verifyArray(errors, json["rings"], prefix + "rings", verifyDecimal(true));
+	verifyValue(errors, json["shape"], prefix + "shape", verifyEnum(Tree_ShapeUtil, false, true));
+	verifyValue(errors, json["level"], prefix + "level", verifyEnum(LevelUtil, false, true));
+	verifyValue(errors, json["name"], prefix + "name", verifyString);
+	verifyValue(errors, json["number"], prefix + "number", verifyInteger(32, false, false));
+	return errors;
+};
+
+/**
+ * The descriptors of the extensions of the message, by field number.
+ * @const {!Object.<number, !Object>}
+ */
+Tree.extensions = {};
+
+/**
+ * @param {{fullName: string, extendee: string, fromJSON: function(*): T}} ext
+ *     The extension.
+ * @return {T} The value of the extension, or its default if unset.
+ * @template T
+ */
+Tree.prototype.getExtension = function(ext) {
+	return ext.fromJSON(this.jsonData_[extensionKey(Tree.TYPE_NAME, ext)]);
+};
+
+/**
+ * @param {{fullName: string, extendee: string, toJSON: function(T): *}} ext
+ *     The extension.
+ * @param {T} value The value of the extension.
+ * @template T
+ */
+Tree.prototype.setExtension = function(ext, value) {
+	this.jsonData_[extensionKey(Tree.TYPE_NAME, ext)] = ext.toJSON(value);
+};
+
+/**
+ * @param {{fullName: string, extendee: string}} ext The extension.
+ * @return {boolean} Whether the extension is set.
+ */
+Tree.prototype.hasExtension = function(ext) {
+	return this.jsonData_[extensionKey(Tree.TYPE_NAME, ext)] != null;
+};
+
+/**
+ * Clears the extension.
+ * @param {{fullName: string, extendee: string}} ext The extension.
+ */
+Tree.prototype.clearExtension = function(ext) {
+	delete this.jsonData_[extensionKey(Tree.TYPE_NAME, ext)];
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+Tree.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	Tree.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!Tree} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+Tree.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["leaf"];
+	if (v != null) {
+		writer.writeMessage(1, new Tree_Leaf(v), Tree_Leaf.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["leaves"];
+	if (v != null) {
+		v.forEach(function(__item) {
+			writer.writeMessage(2, new Tree_Leaf(__item), Tree_Leaf.serializeBinaryToWriter);
+		}, this);
+	}
+	v = message.jsonData_["by_name"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(3, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeMessage(2, new Tree_Leaf(v[__key]), Tree_Leaf.serializeBinaryToWriter);
+			});
+		}
+	}
+	v = message.jsonData_["counts"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(4, __key, function(__key, writer) {
+				writer.writeInt64String(1, __key);
+				writer.writeInt32(2, v[__key]);
+			});
+		}
+	}
+	v = message.jsonData_["height"];
+	if (v != null) {
+		writer.writeInt64String(5, String(v));
+	}
+	v = message.jsonData_["rings"];
+	if (v != null) {
+		v = v.map(function(__v) {
+			return String(__v);
+		});
+		writer.writeRepeatedUint64String(6, v);
+	}
+	v = message.jsonData_["shape"];
+	if (v != null) {
+		writer.writeEnum(7, v);
+	}
+	v = message.jsonData_["level"];
+	if (v != null) {
+		writer.writeEnum(8, v);
+	}
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(9, v);
+	}
+	v = message.jsonData_["number"];
+	if (v != null) {
+		writer.writeInt32(10, v);
+	}
+	for (var __number in Tree.extensions) {
+		var __ext = Tree.extensions[__number];
+		v = message.jsonData_['[' + __ext.fullName + ']'];
+		if (v != null) {
+			__ext.write(v, writer);
+		}
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!Tree} The message.
+ */
+Tree.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return Tree.deserializeBinaryFromReader(new Tree({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!Tree} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Tree} The message.
+ */
+Tree.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new Tree_Leaf({});
+			reader.readMessage(value, Tree_Leaf.deserializeBinaryFromReader);
+			message.jsonData_["leaf"] = value.getJsonData();
+			message.leaf_ = undefined;
+			break;
+		case 2:
+			value = new Tree_Leaf({});
+			reader.readMessage(value, Tree_Leaf.deserializeBinaryFromReader);
+			message.jsonData_["leaves"] = message.jsonData_["leaves"] || [];
+			message.jsonData_["leaves"].push(value.getJsonData());
+			break;
+		case 3:
+			value = {key: '', value: {}};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = new Tree_Leaf({});
+						reader.readMessage(__value, Tree_Leaf.deserializeBinaryFromReader);
+						entry.value = __value.getJsonData();
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["by_name"] = message.jsonData_["by_name"] || {};
+			message.jsonData_["by_name"][String(value.key)] = value.value;
+			break;
+		case 4:
+			value = {key: '0', value: 0};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readInt64String();
+						break;
+					case 2:
+						__value = reader.readInt32();
+						entry.value = __value;
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["counts"] = message.jsonData_["counts"] || {};
+			message.jsonData_["counts"][String(value.key)] = value.value;
+			break;
+		case 5:
+			value = reader.readInt64String();
+			message.jsonData_["height"] = value;
+			break;
+		case 6:
+			value = reader.isDelimited() ? reader.readPackedUint64String() : [reader.readUint64String()];
+			message.jsonData_["rings"] = (message.jsonData_["rings"] || []).concat(value);
+			break;
+		case 7:
+			value = reader.readEnum();
+			message.jsonData_["shape"] = value;
+			break;
+		case 8:
+			value = reader.readEnum();
+			message.jsonData_["level"] = value;
+			break;
+		case 9:
+			delete message.jsonData_["number"];
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		case 10:
+			delete message.jsonData_["name"];
+			value = reader.readInt32();
+			message.jsonData_["number"] = value;
+			break;
+		default:
+			var __ext = Tree.extensions[reader.getFieldNumber()];
+			if (__ext) {
+				var __key = '[' + __ext.fullName + ']';
+				message.jsonData_[__key] = __ext.read(reader, message.jsonData_[__key]);
+			} else {
+				reader.skipField();
+			}
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const Tree_Leaf = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+Tree_Leaf.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+Tree_Leaf.TYPE_NAME = 'test.dts.Tree.Leaf';
+
+/**
+ * @return {string}
+ */
+Tree_Leaf.prototype.getColor = function() {
+	var v = this.jsonData_["color"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} color The color.
+ */
+Tree_Leaf.prototype.setColor = function(color) {
+	this.jsonData_["color"] = color;
+};
+
+/**
+ * @return {boolean} Whether the color is set.
+ */
+Tree_Leaf.prototype.hasColor = function() {
+	return this.jsonData_["color"] != null;
+};
+
+/**
+ * Clears the color.
+ */
+Tree_Leaf.prototype.clearColor = function() {
+	delete this.jsonData_["color"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+Tree_Leaf.prototype.equals = function(other) {
+	if (!(other instanceof Tree_Leaf)) {
+		return false;
+	}
+	if (this.getColor() !== other.getColor()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+Tree_Leaf.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!Tree_Leaf} A copy of the message, sharing no data with it.
+ */
+Tree_Leaf.prototype.clone = function() {
+	return new Tree_Leaf(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!Tree_Leaf} other The other message.
+ */
+Tree_Leaf.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["color"];
+	if (other.hasColor()) {
+		this.jsonData_["color"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+Tree_Leaf.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "color");
+	if (p !== true) {
+		delete this.jsonData_["color"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!Tree_Leaf} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+Tree_Leaf.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasColor() !== other.hasColor() || this.getColor() !== other.getColor()) {
+		paths.push("color");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+Tree_Leaf.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+Tree_Leaf.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["color"], prefix + "color", verifyString);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+Tree_Leaf.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	Tree_Leaf.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!Tree_Leaf} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+Tree_Leaf.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["color"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!Tree_Leaf} The message.
+ */
+Tree_Leaf.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return Tree_Leaf.deserializeBinaryFromReader(new Tree_Leaf({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!Tree_Leaf} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Tree_Leaf} The message.
+ */
+Tree_Leaf.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["color"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * The age extension of test.dts.Tree.
+ * @const
+ */
+export const age = {
+	fieldNumber: 100,
+	fullName: 'test.dts.age',
+	extendee: 'test.dts.Tree',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {string} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? String(v) : '0';
+	},
+	/**
+	 * @param {string} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeInt64String(100, String(v));
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readInt64String();
+		v = value;
+		return v;
+	}
+};
+Tree.extensions[100] = age;
+
+/**
+ * The fallen extension of test.dts.Tree.
+ * @const
+ */
+export const fallen = {
+	fieldNumber: 101,
+	fullName: 'test.dts.fallen',
+	extendee: 'test.dts.Tree',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {Array.<Tree_Leaf>} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return (v || []).map(function(__item) {
+			return new Tree_Leaf(__item);
+		});
+	},
+	/**
+	 * @param {Array.<Tree_Leaf>} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v.map(function(__item) {
+			return __item.getJsonData();
+		});
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		v.forEach(function(__item) {
+			writer.writeMessage(101, new Tree_Leaf(__item), Tree_Leaf.serializeBinaryToWriter);
+		}, this);
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = new Tree_Leaf({});
+		reader.readMessage(value, Tree_Leaf.deserializeBinaryFromReader);
+		v = v || [];
+		v.push(value.getJsonData());
+		return v;
+	}
+};
+Tree.extensions[101] = fallen;
+
+/**
+ * The mood extension of test.dts.Tree.
+ * @const
+ */
+Tree_Leaf.mood = {
+	fieldNumber: 102,
+	fullName: 'test.dts.Tree.Leaf.mood',
+	extendee: 'test.dts.Tree',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {Level} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? v : Level.LOW;
+	},
+	/**
+	 * @param {Level} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeEnum(102, v);
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readEnum();
+		v = value;
+		return v;
+	}
+};
+Tree.extensions[102] = Tree_Leaf.mood;
+
+/**
+ * A client of the test.dts.Forest service.
+ * @param {!ForestClient.Transport|string} transport
+ *     The transport sending the requests, or the base URL of the JSON-over-HTTP
+ *     gateway.
+ * @constructor
+ */
+export const ForestClient = function(transport) {
+	/**
+	 * @private {!ForestClient.Transport}
+	 */
+	this.transport_ = typeof transport === 'string' ? fetchTransport(transport) : transport;
+};
+
+/**
+ * The transport of the client, a local fake or one created by the
+ * transport factories.
+ * @typedef {{call: function(string, string, !Object, ?Object=): !Promise.<*>, stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}}
+ */
+ForestClient.Transport;
+
+/**
+ * Creates the transport sending the requests to a grpc-web server or proxy,
+ * in the binary format or, with text set, the base64 text format of the
+ * grpc-web protocol. It supports server-streaming RPCs.
+ * @param {string} baseUrl The base URL of the server.
+ * @param {boolean=} opt_text Whether to use the text format.
+ * @return {!ForestClient.Transport} The transport.
+ */
+ForestClient.grpcWebTransport = function(baseUrl, opt_text) {
+	return grpcWebTransport(baseUrl, opt_text);
+};
+
+/**
+ * @param {!Tree} request The request.
+ * @return {!Promise.<!Tree>} The response.
+ */
+ForestClient.prototype.grow = function(request) {
+	return this.transport_.call('test.dts.Forest', 'Grow', request.getJsonData(), messageCodec(Tree, Tree)).then(function(data) {
+		return new Tree(data);
+	});
+};
+
+/**
+ * @param {!Tree} request The request.
+ * @param {function(!Tree_Leaf)} onMessage Called with each response.
+ * @param {function(Error)=} opt_onEnd Called when the stream ends, with the
+ *     error ending it or null.
+ * @return {function()} The function cancelling the stream.
+ */
+ForestClient.prototype.watch = function(request, onMessage, opt_onEnd) {
+	return this.transport_.stream('test.dts.Forest', 'Watch', request.getJsonData(), messageCodec(Tree, Tree_Leaf), function(data) {
+		onMessage(new Tree_Leaf(data));
+	}, opt_onEnd || function() {});
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+function appendPaths(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+}
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+function arrayEquals(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+}
+
+/**
+ * Concatenates two byte arrays.
+ * @param {!Uint8Array} a The first bytes.
+ * @param {!Uint8Array} b The second bytes.
+ * @return {!Uint8Array} The concatenation.
+ */
+function concatBytes(a, b) {
+	var bytes = new Uint8Array(a.length + b.length);
+	bytes.set(a);
+	bytes.set(b, a.length);
+	return bytes;
+}
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+function copyJSON(v) {
+	if (Array.isArray(v)) {
+		return v.map(copyJSON);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = copyJSON(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+}
+
+/**
+ * Appends the keys of the extensions differing in the JSON data of two
+ * messages, the keys in brackets, to the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {!Object} a The JSON data of the first message.
+ * @param {!Object} b The JSON data of the second message.
+ */
+function diffExtensions(paths, a, b) {
+	var keys = Object.keys(a).concat(Object.keys(b));
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (key.charAt(0) === '[' && paths.indexOf(key) < 0 && !jsonEquals(a[key], b[key])) {
+			paths.push(key);
+		}
+	}
+}
+
+/**
+ * Returns the key of the value of the extension in the JSON data of the
+ * extended messages, the full name of the extension in brackets.
+ * @param {string} typeName The full name of the type of the message.
+ * @param {{fullName: string, extendee: string}} ext The extension.
+ * @return {string} The key.
+ */
+function extensionKey(typeName, ext) {
+	if (ext.extendee !== typeName) {
+		throw new Error(ext.fullName + ' does not extend ' + typeName);
+	}
+	return '[' + ext.fullName + ']';
+}
+
+/**
+ * Compares the extensions in the JSON data of two messages, the keys in
+ * brackets.
+ * @param {!Object} a The JSON data of the first message.
+ * @param {!Object} b The JSON data of the second message.
+ * @return {boolean} Whether the extensions are equal.
+ */
+function extensionsEqual(a, b) {
+	var keys = Object.keys(a).concat(Object.keys(b));
+	for (var i = 0; i < keys.length; i++) {
+		if (keys[i].charAt(0) === '[' && !jsonEquals(a[keys[i]], b[keys[i]])) {
+			return false;
+		}
+	}
+	return true;
+}
+
+/**
+ * Creates the transport POSTing the JSON data of the requests to a
+ * JSON-over-HTTP gateway, at the base URL followed by "/<service>/<method>".
+ * The gateway can't stream the responses.
+ * @param {string} baseUrl The base URL of the gateway.
+ * @return {!{call: function(string, string, !Object, ?Object=): !Promise.<*>, stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}} The transport.
+ */
+function fetchTransport(baseUrl) {
+	return {
+		call: function(service, method, data) {
+			return fetch(baseUrl + '/' + service + '/' + method, {
+				method: 'POST',
+				headers: {'Content-Type': 'application/json'},
+				body: JSON.stringify(data)
+			}).then(function(response) {
+				if (!response.ok) {
+					throw new Error(service + '/' + method + ': HTTP status ' + response.status);
+				}
+				return response.json();
+			});
+		},
+		stream: unsupportedStream
+	};
+}
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+function fieldMaskPaths(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+}
+
+/**
+ * Creates the error of a failed RPC, with the gRPC status code in code
+ * and its name, like NOT_FOUND, prefixed to the message.
+ * @param {number} code The gRPC status code.
+ * @param {string} message The status message.
+ * @return {!Error} The error.
+ */
+function grpcWebError(code, message) {
+	var names = ['OK', 'CANCELLED', 'UNKNOWN', 'INVALID_ARGUMENT', 'DEADLINE_EXCEEDED',
+		'NOT_FOUND', 'ALREADY_EXISTS', 'PERMISSION_DENIED', 'RESOURCE_EXHAUSTED',
+		'FAILED_PRECONDITION', 'ABORTED', 'OUT_OF_RANGE', 'UNIMPLEMENTED', 'INTERNAL',
+		'UNAVAILABLE', 'DATA_LOSS', 'UNAUTHENTICATED'];
+	var error = new Error((names[code] || 'UNKNOWN') + ': ' + message);
+	error.code = code;
+	return error;
+}
+
+/**
+ * Frames a message in the grpc-web protocol, prefixing the flag byte, 0
+ * for messages, and the length of the message as a 4-byte big-endian integer.
+ * @param {!Uint8Array} bytes The serialized message.
+ * @return {!Uint8Array} The frame.
+ */
+function grpcWebFrame(bytes) {
+	var frame = new Uint8Array(5 + bytes.length);
+	new DataView(frame.buffer).setUint32(1, bytes.length);
+	frame.set(bytes, 5);
+	return frame;
+}
+
+/**
+ * Maps the HTTP status of a failed grpc-web response without
+ * grpc-status to the gRPC status code.
+ * @param {number} status The HTTP status.
+ * @return {number} The gRPC status code.
+ */
+function grpcWebHttpStatus(status) {
+	switch (status) {
+		case 400:
+			return 13;
+		case 401:
+			return 16;
+		case 403:
+			return 7;
+		case 404:
+			return 12;
+		case 429:
+		case 502:
+		case 503:
+		case 504:
+			return 14;
+	}
+	return 2;
+}
+
+/**
+ * Creates the parser of the frames of a grpc-web response, given its
+ * body in chunks. It passes the messages to onMessage and the trailers, the frame
+ * with the 0x80 flag, to onTrailers. In the text format, the body is base64,
+ * possibly in several padded parts.
+ * @param {boolean} text Whether the body is in the text format.
+ * @param {function(!Uint8Array)} onMessage Called with each message.
+ * @param {function(!Object.<string, string>)} onTrailers Called with the trailers.
+ * @return {function(!Uint8Array)} The function parsing the next chunk.
+ */
+function grpcWebParser(text, onMessage, onTrailers) {
+	var buffer = new Uint8Array(0);
+	var pending = '';
+	return function(chunk) {
+		if (text) {
+			// Decode the complete groups of 4 characters, part by part.
+			pending += new TextDecoder().decode(chunk);
+			var n = pending.length - pending.length % 4;
+			var parts = pending.substring(0, n).match(/[^=]+=*|=+/g) || [];
+			pending = pending.substring(n);
+			chunk = new Uint8Array(0);
+			for (var i = 0; i < parts.length; i++) {
+				chunk = concatBytes(chunk, jspb.Message.bytesAsU8(parts[i]));
+			}
+		}
+		buffer = concatBytes(buffer, chunk);
+		while (buffer.length >= 5) {
+			var length = new DataView(buffer.buffer, buffer.byteOffset).getUint32(1);
+			if (buffer.length < 5 + length) {
+				break;
+			}
+			var payload = buffer.subarray(5, 5 + length);
+			if (buffer[0] & 0x80) {
+				var trailers = {};
+				new TextDecoder().decode(payload).split('\r\n').forEach(function(line) {
+					var colon = line.indexOf(':');
+					if (colon > 0) {
+						trailers[line.substring(0, colon).trim().toLowerCase()] = line.substring(colon + 1).trim();
+					}
+				});
+				onTrailers(trailers);
+			} else {
+				onMessage(payload);
+			}
+			buffer = buffer.subarray(5 + length);
+		}
+	};
+}
+
+/**
+ * Converts the grpc-status and grpc-message of a grpc-web response
+ * into its error, or null for OK.
+ * @param {?string|undefined} status The status code.
+ * @param {?string|undefined} message The percent-encoded status message.
+ * @return {Error} The error, or null.
+ */
+function grpcWebStatus(status, message) {
+	if (status == null) {
+		return grpcWebError(13, 'missing grpc-status');
+	}
+	var code = Number(status);
+	if (code === 0) {
+		return null;
+	}
+	return grpcWebError(isNaN(code) ? 2 : code, message ? decodeURIComponent(message) : '');
+}
+
+/**
+ * Creates the transport sending the requests with the grpc-web
+ * protocol, in the binary format or, with text set, the base64 text format, to
+ * the base URL followed by "/<service>/<method>". It uses fetch and, to cancel
+ * the streams, AbortController. The failed RPCs end with errors carrying the
+ * gRPC status code in code.
+ * @param {string} baseUrl The base URL of the server.
+ * @param {boolean=} opt_text Whether to use the text format.
+ * @return {!{call: function(string, string, !Object, ?Object=): !Promise.<*>, stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}} The transport.
+ */
+function grpcWebTransport(baseUrl, opt_text) {
+	var contentType = opt_text ? 'application/grpc-web-text' : 'application/grpc-web+proto';
+	var transport = {
+		call: function(service, method, data, codec) {
+			return new Promise(function(resolve, reject) {
+				var responses = [];
+				transport.stream(service, method, data, codec || null, function(data) {
+					responses.push(data);
+				}, function(error) {
+					if (error) {
+						reject(error);
+					} else if (responses.length != 1) {
+						reject(grpcWebError(13, 'expected 1 response, got ' + responses.length));
+					} else {
+						resolve(responses[0]);
+					}
+				});
+			});
+		},
+		stream: function(service, method, data, codec, onData, onEnd) {
+			var ended = false;
+			var end = function(error) {
+				if (!ended) {
+					ended = true;
+					onEnd(error);
+				}
+			};
+			if (!codec) {
+				end(grpcWebError(12, service + '/' + method + ' has no binary serialization'));
+				return function() {};
+			}
+			var controller = typeof AbortController != 'undefined' ? new AbortController() : null;
+			var frame = grpcWebFrame(codec.serialize(data));
+			fetch(baseUrl + '/' + service + '/' + method, {
+				method: 'POST',
+				headers: {'Content-Type': contentType, 'Accept': contentType, 'X-Grpc-Web': '1'},
+				body: opt_text ? jspb.Message.bytesAsB64(frame) : frame,
+				signal: controller ? controller.signal : undefined
+			}).then(function(response) {
+				if (response.headers.get('grpc-status') != null) {
+					// A trailers-only response.
+					end(grpcWebStatus(response.headers.get('grpc-status'), response.headers.get('grpc-message')));
+					return;
+				}
+				if (!response.ok) {
+					end(grpcWebError(grpcWebHttpStatus(response.status), 'HTTP status ' + response.status));
+					return;
+				}
+				var parse = grpcWebParser(!!opt_text, function(bytes) {
+					if (!ended) {
+						onData(codec.deserialize(bytes));
+					}
+				}, function(trailers) {
+					end(grpcWebStatus(trailers['grpc-status'], trailers['grpc-message']));
+				});
+				var reader = response.body.getReader();
+				var pump = function() {
+					return reader.read().then(function(chunk) {
+						if (chunk.done) {
+							end(grpcWebError(13, 'missing trailers'));
+						} else if (!ended) {
+							parse(chunk.value);
+							return pump();
+						}
+					});
+				};
+				return pump();
+			}).catch(function(error) {
+				end(grpcWebError(14, String(error && error.message || error)));
+			});
+			return function() {
+				end(grpcWebError(1, 'cancelled'));
+				if (controller) {
+					controller.abort();
+				}
+			};
+		}
+	};
+	return transport;
+}
+
+/**
+ * Compares two JSON values deeply, NaN being equal to NaN.
+ * @param {*} a The first value.
+ * @param {*} b The second value.
+ * @return {boolean} Whether the values are equal.
+ */
+function jsonEquals(a, b) {
+	if (a === b || (a !== a && b !== b)) {
+		return true;
+	}
+	if (a === null || b === null || typeof a !== 'object' || typeof b !== 'object' ||
+			Array.isArray(a) !== Array.isArray(b)) {
+		return false;
+	}
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		if (!Object.prototype.hasOwnProperty.call(b, keys[i]) || !jsonEquals(a[keys[i]], b[keys[i]])) {
+			return false;
+		}
+	}
+	return true;
+}
+
+/**
+ * Merges the extensions in the JSON data of a message, the keys in
+ * brackets, into the JSON data of another message of the same type. The values
+ * of the repeated extensions are appended, the messages of the registered
+ * extensions are merged and the other values overwrite the previous ones.
+ * @param {!Object.<number, !Object>} extensions The registered extensions of the
+ *     type of the messages.
+ * @param {!Object} to The JSON data of the message merged into.
+ * @param {!Object} from The JSON data of the merged message.
+ */
+function mergeExtensions(extensions, to, from) {
+	var byName = {};
+	for (var number in extensions) {
+		byName['[' + extensions[number].fullName + ']'] = extensions[number];
+	}
+	for (var key in from) {
+		var v = from[key];
+		if (key.charAt(0) !== '[' || v == null) {
+			continue;
+		}
+		if (Array.isArray(v)) {
+			to[key] = (to[key] || []).concat(copyJSON(v));
+			continue;
+		}
+		var ext = byName[key];
+		var value = ext && to[key] != null ? ext.fromJSON(to[key]) : null;
+		if (value && typeof value.mergeFrom === 'function') {
+			value.mergeFrom(ext.fromJSON(v));
+		} else {
+			to[key] = copyJSON(v);
+		}
+	}
+}
+
+/**
+ * Creates the codec converting the JSON data of the requests and the
+ * responses of a method to and from the binary wire format. A null class stands
+ * for google.protobuf.Empty.
+ * @param {?Function} requestClass The class of the requests.
+ * @param {?Function} responseClass The class of the responses.
+ * @return {{serialize: function(!Object): !Uint8Array, deserialize: function(!Uint8Array): !Object}}
+ *     The codec.
+ */
+function messageCodec(requestClass, responseClass) {
+	return {
+		serialize: function(data) {
+			return requestClass ? new requestClass(data).serializeBinary() : new Uint8Array(0);
+		},
+		deserialize: function(bytes) {
+			return responseClass ? responseClass.deserializeBinary(bytes).getJsonData() : {};
+		}
+	};
+}
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+function messageEquals(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+}
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+function nestedViolations(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+}
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+function objectEquals(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+}
+
+/**
+ * Deletes the extensions in the JSON data of a message, the keys in
+ * brackets, missing from the paths of a field mask. The paths of the extensions
+ * are their keys, like [foo.bar.baz].
+ * @param {!Object} data The JSON data.
+ * @param {!Array.<string>} paths The paths.
+ */
+function pruneExtensions(data, paths) {
+	for (var key in data) {
+		if (key.charAt(0) === '[' && paths.indexOf(key) < 0) {
+			delete data[key];
+		}
+	}
+}
+
+/**
+ * Ends with an error the streams of the transports not supporting
+ * server-streaming RPCs.
+ * @param {string} service The full name of the service.
+ * @param {string} method The name of the method.
+ * @param {!Object} data The JSON data of the request.
+ * @param {?Object} codec The binary codec of the messages.
+ * @param {function(*)} onData Called with the JSON data of each response.
+ * @param {function(Error)} onEnd Called when the stream ends.
+ * @return {function()} The function cancelling the stream.
+ */
+function unsupportedStream(service, method, data, codec, onData, onEnd) {
+	onEnd(new Error(service + '/' + method + ': the transport doesn\'t support streaming'));
+	return function() {};
+}
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+function verifyArray(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(verifyError(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+}
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyDecimal(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[verifyError(path, expected, v)];
+	};
+}
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyEnum(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[verifyError(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [verifyError(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [verifyError(path, 'a value of the enum', v)];
+	};
+}
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+function verifyError(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+}
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyInteger(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return verifyDecimal(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[verifyError(path, expected, v)];
+	};
+}
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+function verifyMap(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(verifyError(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+}
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+function verifyString(v, path) {
+	return typeof v === 'string' ? [] : [verifyError(path, 'a string', v)];
+}
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+function verifyValue(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+}
+
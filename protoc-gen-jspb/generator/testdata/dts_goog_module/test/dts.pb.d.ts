@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-js.
+// source: test/dts.proto
+// DO NOT EDIT!
+
+declare module 'goog:test.dts.dts' {
+
+	export enum Level {
+		LOW = 0,
+		HIGH = 1,
+	}
+
+	export const LevelUtil: {readonly names: {readonly [value: number]: string}; nameOf(value: number): string | null; valueOf(name: string): Level | null; values(): Level[]; isValid(value: number): boolean};
+
+	export enum Tree_Shape {
+		ROUND = 0,
+		TALL = 1,
+	}
+
+	export const Tree_ShapeUtil: {readonly names: {readonly [value: number]: string}; nameOf(value: number): string | null; valueOf(name: string): Tree_Shape | null; values(): Tree_Shape[]; isValid(value: number): boolean};
+
+	export class Tree {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getLeaf(): Tree_Leaf;
+		setLeaf(leaf: Tree_Leaf): void;
+		hasLeaf(): boolean;
+		clearLeaf(): void;
+		getLeaves(): Array<Tree_Leaf>;
+		setLeaves(leaves: Array<Tree_Leaf>): void;
+		getByName(): {[key: string]: Tree_Leaf};
+		setByName(by_name: {[key: string]: Tree_Leaf}): void;
+		getByNameMap(): Map<string, Tree_Leaf>;
+		setByNameMap(by_name: Map<string, Tree_Leaf>): void;
+		getCounts(): {[key: string]: number};
+		setCounts(counts: {[key: string]: number}): void;
+		getCountsMap(): Map<goog.math.Long, number>;
+		setCountsMap(counts: Map<goog.math.Long, number>): void;
+		getHeight(): goog.math.Long;
+		setHeight(height: goog.math.Long): void;
+		hasHeight(): boolean;
+		clearHeight(): void;
+		getRings(): Array<goog.math.Long>;
+		setRings(rings: Array<goog.math.Long>): void;
+		getShape(): Tree_Shape;
+		setShape(shape: Tree_Shape): void;
+		hasShape(): boolean;
+		clearShape(): void;
+		getLevel(): Level;
+		setLevel(level: Level): void;
+		hasLevel(): boolean;
+		clearLevel(): void;
+		getName(): string;
+		setName(name: string): void;
+		hasName(): boolean;
+		clearName(): void;
+		getNumber(): number;
+		setNumber(number: number): void;
+		hasNumber(): boolean;
+		clearNumber(): void;
+		getKindCase(): Tree.KindCase;
+		clearKind(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): Tree;
+		mergeFrom(other: Tree): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: Tree): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		static extensions: {[fieldNumber: number]: Object};
+		getExtension<T>(ext: {readonly fullName: string; readonly extendee: string; fromJSON(v: any): T}): T;
+		setExtension<T>(ext: {readonly fullName: string; readonly extendee: string; toJSON(v: T): any}, value: T): void;
+		hasExtension(ext: {readonly fullName: string; readonly extendee: string}): boolean;
+		clearExtension(ext: {readonly fullName: string; readonly extendee: string}): void;
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): Tree;
+	}
+
+	export class Tree_Leaf {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getColor(): string;
+		setColor(color: string): void;
+		hasColor(): boolean;
+		clearColor(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): Tree_Leaf;
+		mergeFrom(other: Tree_Leaf): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: Tree_Leaf): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): Tree_Leaf;
+		static mood: {readonly fieldNumber: number; readonly fullName: string; readonly extendee: string; fromJSON(v: any): Level; toJSON(v: Level): any; write(v: any, writer: any): void; read(reader: any, v: any): any};
+	}
+
+	export const age: {readonly fieldNumber: number; readonly fullName: string; readonly extendee: string; fromJSON(v: any): goog.math.Long; toJSON(v: goog.math.Long): any; write(v: any, writer: any): void; read(reader: any, v: any): any};
+
+	export const fallen: {readonly fieldNumber: number; readonly fullName: string; readonly extendee: string; fromJSON(v: any): Array<Tree_Leaf>; toJSON(v: Array<Tree_Leaf>): any; write(v: any, writer: any): void; read(reader: any, v: any): any};
+
+	export class ForestClient {
+		constructor(transport: ForestClient.Transport | string);
+		static grpcWebTransport(baseUrl: string, text?: boolean): ForestClient.Transport;
+		grow(request: Tree): Promise<Tree>;
+		watch(request: Tree, onMessage: (response: Tree_Leaf) => void, onEnd?: (error: Error | null) => void): () => void;
+	}
+
+	export namespace Tree {
+		enum KindCase {
+			KIND_NOT_SET = 0,
+			NAME = 9,
+			NUMBER = 10,
+		}
+	}
+
+	export namespace ForestClient {
+		interface Transport {
+			call(service: string, method: string, data: Object, codec?: Object | null): Promise<any>;
+			stream?(service: string, method: string, data: Object, codec: Object | null, onData: (data: any) => void, onEnd: (error: Error | null) => void): () => void;
+		}
+	}
+}
@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-js.
+// source: test/enums.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.enums.Color');
+goog.provide('test.enums.ColorUtil');
+goog.provide('test.enums.Paint_Finish');
+goog.provide('test.enums.Paint_FinishUtil');
+goog.provide('test.enums.Paint');
+goog.provide('test.enums.Tint');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * The primary colors.
+ * @enum {number}
+ */
+test.enums.Color = {
+	RED: 0,
+	/**
+	 * Not to be confused with lime.
+	 */
+	GREEN: 1,
+	/**
+	 * Too dark.
+	 * @deprecated
+	 */
+	BLUE: 2
+};
+
+/**
+ * The helpers of the test.enums.Color enum.
+ * @const
+ */
+test.enums.ColorUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		0: 'RED',
+		1: 'GREEN',
+		2: 'BLUE'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return test.enums.ColorUtil.isValid(value) ? test.enums.ColorUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?test.enums.Color} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(test.enums.Color, name) ? test.enums.Color[name] : null;
+	},
+	/**
+	 * @return {!Array.<test.enums.Color>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [0, 1, 2];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(test.enums.ColorUtil.names, value);
+	}
+};
+
+/**
+ * @deprecated
+ * @enum {number}
+ */
+test.enums.Paint_Finish = {
+	MATTE: 0,
+	GLOSS: 1
+};
+
+/**
+ * The helpers of the test.enums.Paint_Finish enum.
+ * @const
+ */
+test.enums.Paint_FinishUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		0: 'MATTE',
+		1: 'GLOSS'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return test.enums.Paint_FinishUtil.isValid(value) ? test.enums.Paint_FinishUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?test.enums.Paint_Finish} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(test.enums.Paint_Finish, name) ? test.enums.Paint_Finish[name] : null;
+	},
+	/**
+	 * @return {!Array.<test.enums.Paint_Finish>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [0, 1];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(test.enums.Paint_FinishUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.enums.Paint = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.enums.Paint.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.enums.Paint.TYPE_NAME = 'test.enums.Paint';
+
+/**
+ * @return {test.enums.Color}
+ */
+test.enums.Paint.prototype.getColor = function() {
+	var v = this.jsonData_["color"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {test.enums.Color} color The color.
+ */
+test.enums.Paint.prototype.setColor = function(color) {
+	this.jsonData_["color"] = color;
+};
+
+/**
+ * @return {boolean} Whether the color is set to a value other than the default.
+ */
+test.enums.Paint.prototype.hasColor = function() {
+	return this.getColor() !== 0;
+};
+
+/**
+ * Clears the color.
+ */
+test.enums.Paint.prototype.clearColor = function() {
+	delete this.jsonData_["color"];
+};
+
+/**
+ * @deprecated
+ * @return {test.enums.Paint_Finish}
+ */
+test.enums.Paint.prototype.getFinish = function() {
+	var v = this.jsonData_["finish"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @deprecated
+ * @param {test.enums.Paint_Finish} finish The finish.
+ */
+test.enums.Paint.prototype.setFinish = function(finish) {
+	this.jsonData_["finish"] = finish;
+};
+
+/**
+ * @return {boolean} Whether the finish is set to a value other than the default.
+ * @deprecated
+ */
+test.enums.Paint.prototype.hasFinish = function() {
+	return this.getFinish() !== 0;
+};
+
+/**
+ * Clears the finish.
+ * @deprecated
+ */
+test.enums.Paint.prototype.clearFinish = function() {
+	delete this.jsonData_["finish"];
+};
+
+/**
+ * @return {Array.<number>}
+ */
+test.enums.Paint.prototype.getPalette = function() {
+	return this.jsonData_["palette"] || [];
+};
+
+/**
+ * @param {Array.<number>} palette The palette.
+ */
+test.enums.Paint.prototype.setPalette = function(palette) {
+	this.jsonData_["palette"] = palette;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.enums.Paint.prototype.equals = function(other) {
+	if (!(other instanceof test.enums.Paint)) {
+		return false;
+	}
+	if (this.getColor() !== other.getColor()) {
+		return false;
+	}
+	if (this.getFinish() !== other.getFinish()) {
+		return false;
+	}
+	if (!test.enums.arrayEquals_(this.getPalette(), other.getPalette(), null)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.enums.Paint.prototype.deepCopy = function() {
+	return test.enums.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.enums.Paint} A copy of the message, sharing no data with it.
+ */
+test.enums.Paint.prototype.clone = function() {
+	return new test.enums.Paint(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.enums.Paint} other The other message.
+ */
+test.enums.Paint.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["color"];
+	if (other.hasColor()) {
+		this.jsonData_["color"] = v;
+	}
+	v = other.jsonData_["finish"];
+	if (other.hasFinish()) {
+		this.jsonData_["finish"] = v;
+	}
+	v = other.jsonData_["palette"];
+	if (v != null && v.length) {
+		this.jsonData_["palette"] = (this.jsonData_["palette"] || []).concat(v);
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.enums.Paint.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.enums.fieldMaskPaths_(paths, "color");
+	if (p !== true) {
+		delete this.jsonData_["color"];
+	}
+	p = test.enums.fieldMaskPaths_(paths, "finish");
+	if (p !== true) {
+		delete this.jsonData_["finish"];
+	}
+	p = test.enums.fieldMaskPaths_(paths, "palette");
+	if (p !== true) {
+		delete this.jsonData_["palette"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.enums.Paint} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.enums.Paint.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getColor() !== other.getColor()) {
+		paths.push("color");
+	}
+	if (this.getFinish() !== other.getFinish()) {
+		paths.push("finish");
+	}
+	if (!test.enums.arrayEquals_(this.getPalette(), other.getPalette(), null)) {
+		paths.push("palette");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.enums.Paint.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.enums.Paint.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.enums.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.enums.verifyValue_(errors, json["color"], prefix + "color", test.enums.verifyEnum_(test.enums.ColorUtil, false, false));
+	test.enums.verifyValue_(errors, json["finish"], prefix + "finish", test.enums.verifyEnum_(test.enums.Paint_FinishUtil, false, false));
+	test.enums.verifyArray_(errors, json["palette"], prefix + "palette", test.enums.verifyEnum_(test.enums.ColorUtil, false, false));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.enums.Paint.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.enums.Paint.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.enums.Paint} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.enums.Paint.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["color"];
+	if (v != null) {
+		writer.writeEnum(1, v);
+	}
+	v = message.jsonData_["finish"];
+	if (v != null) {
+		writer.writeEnum(2, v);
+	}
+	v = message.jsonData_["palette"];
+	if (v != null) {
+		writer.writePackedEnum(3, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.enums.Paint} The message.
+ */
+test.enums.Paint.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.enums.Paint.deserializeBinaryFromReader(new test.enums.Paint({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.enums.Paint} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.enums.Paint} The message.
+ */
+test.enums.Paint.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readEnum();
+			message.jsonData_["color"] = value;
+			break;
+		case 2:
+			value = reader.readEnum();
+			message.jsonData_["finish"] = value;
+			break;
+		case 3:
+			value = reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()];
+			message.jsonData_["palette"] = (message.jsonData_["palette"] || []).concat(value);
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @deprecated
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.enums.Tint = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.enums.Tint.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.enums.Tint.TYPE_NAME = 'test.enums.Tint';
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.enums.Tint.prototype.equals = function(other) {
+	if (!(other instanceof test.enums.Tint)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.enums.Tint.prototype.deepCopy = function() {
+	return test.enums.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.enums.Tint} A copy of the message, sharing no data with it.
+ */
+test.enums.Tint.prototype.clone = function() {
+	return new test.enums.Tint(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.enums.Tint} other The other message.
+ */
+test.enums.Tint.prototype.mergeFrom = function(other) {
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.enums.Tint.prototype.applyFieldMask = function(paths) {
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.enums.Tint} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.enums.Tint.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.enums.Tint.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.enums.Tint.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.enums.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.enums.Tint.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.enums.Tint.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.enums.Tint} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.enums.Tint.serializeBinaryToWriter = function(message, writer) {
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.enums.Tint} The message.
+ */
+test.enums.Tint.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.enums.Tint.deserializeBinaryFromReader(new test.enums.Tint({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.enums.Tint} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.enums.Tint} The message.
+ */
+test.enums.Tint.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.enums.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.enums.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.enums.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.enums.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.enums.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.enums.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.enums.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.enums.verifyEnum_ = function(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[test.enums.verifyError_(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [test.enums.verifyError_(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [test.enums.verifyError_(path, 'a value of the enum', v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.enums.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.enums.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-js.
+// source: test/equals.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.equals.Sample');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.equals.Sample = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.equals.Sample.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.equals.Sample.TYPE_NAME = 'test.equals.Sample';
+
+/**
+ * @return {number}
+ */
+test.equals.Sample.prototype.getRatio = function() {
+	var v = this.jsonData_["ratio"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} ratio The ratio.
+ */
+test.equals.Sample.prototype.setRatio = function(ratio) {
+	this.jsonData_["ratio"] = ratio;
+};
+
+/**
+ * @return {boolean} Whether the ratio is set to a value other than the default.
+ */
+test.equals.Sample.prototype.hasRatio = function() {
+	return this.getRatio() !== 0;
+};
+
+/**
+ * Clears the ratio.
+ */
+test.equals.Sample.prototype.clearRatio = function() {
+	delete this.jsonData_["ratio"];
+};
+
+/**
+ * @return {number}
+ */
+test.equals.Sample.prototype.getLimit = function() {
+	var v = this.jsonData_["limit"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} limit The limit.
+ */
+test.equals.Sample.prototype.setLimit = function(limit) {
+	this.jsonData_["limit"] = limit;
+};
+
+/**
+ * @return {boolean} Whether the limit is set.
+ */
+test.equals.Sample.prototype.hasLimit = function() {
+	return this.jsonData_["limit"] != null;
+};
+
+/**
+ * Clears the limit.
+ */
+test.equals.Sample.prototype.clearLimit = function() {
+	delete this.jsonData_["limit"];
+};
+
+/**
+ * @return {number}
+ */
+test.equals.Sample.prototype.getId = function() {
+	var v = this.jsonData_["id"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} id The id.
+ */
+test.equals.Sample.prototype.setId = function(id) {
+	this.jsonData_["id"] = id;
+};
+
+/**
+ * @return {boolean} Whether the id is set to a value other than the default.
+ */
+test.equals.Sample.prototype.hasId = function() {
+	return this.getId() !== 0;
+};
+
+/**
+ * Clears the id.
+ */
+test.equals.Sample.prototype.clearId = function() {
+	delete this.jsonData_["id"];
+};
+
+/**
+ * @return {Array.<number>}
+ */
+test.equals.Sample.prototype.getSamples = function() {
+	return this.jsonData_["samples"] || [];
+};
+
+/**
+ * @param {Array.<number>} samples The samples.
+ */
+test.equals.Sample.prototype.setSamples = function(samples) {
+	this.jsonData_["samples"] = samples;
+};
+
+/**
+ * @return {Object.<string, number>}
+ */
+test.equals.Sample.prototype.getTotals = function() {
+	return this.jsonData_["totals"] || {};
+};
+
+/**
+ * @param {Object.<string, number>} totals The totals.
+ */
+test.equals.Sample.prototype.setTotals = function(totals) {
+	this.jsonData_["totals"] = totals;
+};
+
+/**
+ * @return {!Map.<string, number>}
+ */
+test.equals.Sample.prototype.getTotalsMap = function() {
+	var __map = new Map();
+	var __obj = this.getTotals();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, number>} totals The totals.
+ */
+test.equals.Sample.prototype.setTotalsMap = function(totals) {
+	var __obj = {};
+	totals.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setTotals(__obj);
+};
+
+/**
+ * @return {test.equals.Sample}
+ */
+test.equals.Sample.prototype.getChild = function() {
+	if (this.child_) {
+		return this.child_;
+	}
+	var v = this.jsonData_["child"];
+	if (v) {
+		/** @private {test.equals.Sample} */
+		this.child_ = new test.equals.Sample(v);
+		return this.child_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.equals.Sample} child The child.
+ */
+test.equals.Sample.prototype.setChild = function(child) {
+	this.jsonData_["child"] = child.getJsonData();
+	this.child_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the child is set.
+ */
+test.equals.Sample.prototype.hasChild = function() {
+	return this.jsonData_["child"] != null;
+};
+
+/**
+ * Clears the child.
+ */
+test.equals.Sample.prototype.clearChild = function() {
+	delete this.jsonData_["child"];
+	this.child_ = undefined;
+};
+
+/**
+ * @return {string}
+ */
+test.equals.Sample.prototype.getText = function() {
+	var v = this.jsonData_["text"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} text The text.
+ */
+test.equals.Sample.prototype.setText = function(text) {
+	this.clearChoice();
+	this.jsonData_["text"] = text;
+};
+
+/**
+ * @return {boolean} Whether the text is set.
+ */
+test.equals.Sample.prototype.hasText = function() {
+	return this.jsonData_["text"] != null;
+};
+
+/**
+ * Clears the text.
+ */
+test.equals.Sample.prototype.clearText = function() {
+	delete this.jsonData_["text"];
+};
+
+/**
+ * @return {number}
+ */
+test.equals.Sample.prototype.getNumber = function() {
+	var v = this.jsonData_["number"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} number The number.
+ */
+test.equals.Sample.prototype.setNumber = function(number) {
+	this.clearChoice();
+	this.jsonData_["number"] = number;
+};
+
+/**
+ * @return {boolean} Whether the number is set.
+ */
+test.equals.Sample.prototype.hasNumber = function() {
+	return this.jsonData_["number"] != null;
+};
+
+/**
+ * Clears the number.
+ */
+test.equals.Sample.prototype.clearNumber = function() {
+	delete this.jsonData_["number"];
+};
+
+/**
+ * @enum {number}
+ */
+test.equals.Sample.ChoiceCase = {
+	CHOICE_NOT_SET: 0,
+	TEXT: 7,
+	NUMBER: 8
+};
+
+/**
+ * @return {test.equals.Sample.ChoiceCase} The case of the choice oneof.
+ */
+test.equals.Sample.prototype.getChoiceCase = function() {
+	if (this.jsonData_["text"] != null) {
+		return test.equals.Sample.ChoiceCase.TEXT;
+	}
+	if (this.jsonData_["number"] != null) {
+		return test.equals.Sample.ChoiceCase.NUMBER;
+	}
+	return test.equals.Sample.ChoiceCase.CHOICE_NOT_SET;
+};
+
+/**
+ * Clears all members of the choice oneof.
+ */
+test.equals.Sample.prototype.clearChoice = function() {
+	delete this.jsonData_["text"];
+	delete this.jsonData_["number"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.equals.Sample.prototype.equals = function(other) {
+	if (!(other instanceof test.equals.Sample)) {
+		return false;
+	}
+	if (!test.equals.floatEquals_(this.getRatio(), other.getRatio())) {
+		return false;
+	}
+	if (!test.equals.floatEquals_(this.getLimit(), other.getLimit())) {
+		return false;
+	}
+	if (this.getId() !== other.getId()) {
+		return false;
+	}
+	if (!test.equals.arrayEquals_(this.getSamples(), other.getSamples(), test.equals.floatEquals_)) {
+		return false;
+	}
+	if (!test.equals.objectEquals_(this.getTotals(), other.getTotals(), null)) {
+		return false;
+	}
+	if (!test.equals.messageEquals_(this.getChild(), other.getChild())) {
+		return false;
+	}
+	if (this.getText() !== other.getText()) {
+		return false;
+	}
+	if (this.getNumber() !== other.getNumber()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.equals.Sample.prototype.deepCopy = function() {
+	return test.equals.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.equals.Sample} A copy of the message, sharing no data with it.
+ */
+test.equals.Sample.prototype.clone = function() {
+	return new test.equals.Sample(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.equals.Sample} other The other message.
+ */
+test.equals.Sample.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["ratio"];
+	if (other.hasRatio()) {
+		this.jsonData_["ratio"] = v;
+	}
+	v = other.jsonData_["limit"];
+	if (other.hasLimit()) {
+		this.jsonData_["limit"] = v;
+	}
+	v = other.jsonData_["id"];
+	if (other.hasId()) {
+		this.jsonData_["id"] = v;
+	}
+	v = other.jsonData_["samples"];
+	if (v != null && v.length) {
+		this.jsonData_["samples"] = (this.jsonData_["samples"] || []).concat(v);
+	}
+	v = other.jsonData_["totals"];
+	if (v != null) {
+		this.jsonData_["totals"] = this.jsonData_["totals"] || {};
+		for (var __key in v) {
+			this.jsonData_["totals"][__key] = test.equals.copyJSON_(v[__key]);
+		}
+	}
+	v = other.jsonData_["child"];
+	if (other.hasChild()) {
+		if (this.hasChild()) {
+			this.getChild().mergeFrom(other.getChild());
+		} else {
+			this.jsonData_["child"] = test.equals.copyJSON_(v);
+		}
+		this.child_ = undefined;
+	}
+	v = other.jsonData_["text"];
+	if (other.hasText()) {
+		this.clearChoice();
+		this.jsonData_["text"] = v;
+	}
+	v = other.jsonData_["number"];
+	if (other.hasNumber()) {
+		this.clearChoice();
+		this.jsonData_["number"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.equals.Sample.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.equals.fieldMaskPaths_(paths, "ratio");
+	if (p !== true) {
+		delete this.jsonData_["ratio"];
+	}
+	p = test.equals.fieldMaskPaths_(paths, "limit");
+	if (p !== true) {
+		delete this.jsonData_["limit"];
+	}
+	p = test.equals.fieldMaskPaths_(paths, "id");
+	if (p !== true) {
+		delete this.jsonData_["id"];
+	}
+	p = test.equals.fieldMaskPaths_(paths, "samples");
+	if (p !== true) {
+		delete this.jsonData_["samples"];
+	}
+	p = test.equals.fieldMaskPaths_(paths, "totals");
+	if (p !== true) {
+		delete this.jsonData_["totals"];
+		this.totals_ = undefined;
+	}
+	p = test.equals.fieldMaskPaths_(paths, "child");
+	if (p !== true) {
+		if (p.length && this.hasChild()) {
+			this.getChild().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["child"];
+			this.child_ = undefined;
+		}
+	}
+	p = test.equals.fieldMaskPaths_(paths, "text");
+	if (p !== true) {
+		delete this.jsonData_["text"];
+	}
+	p = test.equals.fieldMaskPaths_(paths, "number");
+	if (p !== true) {
+		delete this.jsonData_["number"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.equals.Sample} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.equals.Sample.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (!test.equals.floatEquals_(this.getRatio(), other.getRatio())) {
+		paths.push("ratio");
+	}
+	if (this.hasLimit() !== other.hasLimit() || !test.equals.floatEquals_(this.getLimit(), other.getLimit())) {
+		paths.push("limit");
+	}
+	if (this.getId() !== other.getId()) {
+		paths.push("id");
+	}
+	if (!test.equals.arrayEquals_(this.getSamples(), other.getSamples(), test.equals.floatEquals_)) {
+		paths.push("samples");
+	}
+	if (!test.equals.objectEquals_(this.getTotals(), other.getTotals(), null)) {
+		paths.push("totals");
+	}
+	if (this.hasChild() && other.hasChild()) {
+		test.equals.appendPaths_(paths, "child.", this.getChild().diffFieldMask(other.getChild()));
+	} else if (this.hasChild() !== other.hasChild()) {
+		paths.push("child");
+	}
+	if (this.hasText() !== other.hasText() || this.getText() !== other.getText()) {
+		paths.push("text");
+	}
+	if (this.hasNumber() !== other.hasNumber() || this.getNumber() !== other.getNumber()) {
+		paths.push("number");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.equals.Sample.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasChild()) {
+		v = this.getChild();
+		test.equals.nestedViolations_(violations, "child", v.validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.equals.Sample.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.equals.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.equals.verifyValue_(errors, json["ratio"], prefix + "ratio", test.equals.verifyNumber_);
+	test.equals.verifyValue_(errors, json["limit"], prefix + "limit", test.equals.verifyNumber_);
+	test.equals.verifyValue_(errors, json["id"], prefix + "id", test.equals.verifyInteger_(64, false, false));
+	test.equals.verifyArray_(errors, json["samples"], prefix + "samples", test.equals.verifyNumber_);
+	test.equals.verifyMap_(errors, json["totals"], prefix + "totals", test.equals.verifyInteger_(64, false, false));
+	test.equals.verifyValue_(errors, json["child"], prefix + "child", test.equals.Sample.verify);
+	test.equals.verifyValue_(errors, json["text"], prefix + "text", test.equals.verifyString_);
+	test.equals.verifyValue_(errors, json["number"], prefix + "number", test.equals.verifyInteger_(64, false, false));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.equals.Sample.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.equals.Sample.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.equals.Sample} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.equals.Sample.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["ratio"];
+	if (v != null) {
+		writer.writeDouble(1, v);
+	}
+	v = message.jsonData_["limit"];
+	if (v != null) {
+		writer.writeFloat(2, v);
+	}
+	v = message.jsonData_["id"];
+	if (v != null) {
+		writer.writeInt64(3, v);
+	}
+	v = message.jsonData_["samples"];
+	if (v != null) {
+		writer.writePackedDouble(4, v);
+	}
+	v = message.jsonData_["totals"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(5, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeInt64(2, v[__key]);
+			});
+		}
+	}
+	v = message.jsonData_["child"];
+	if (v != null) {
+		writer.writeMessage(6, new test.equals.Sample(v), test.equals.Sample.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["text"];
+	if (v != null) {
+		writer.writeString(7, v);
+	}
+	v = message.jsonData_["number"];
+	if (v != null) {
+		writer.writeInt64(8, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.equals.Sample} The message.
+ */
+test.equals.Sample.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.equals.Sample.deserializeBinaryFromReader(new test.equals.Sample({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.equals.Sample} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.equals.Sample} The message.
+ */
+test.equals.Sample.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readDouble();
+			message.jsonData_["ratio"] = value;
+			break;
+		case 2:
+			value = reader.readFloat();
+			message.jsonData_["limit"] = value;
+			break;
+		case 3:
+			value = reader.readInt64();
+			message.jsonData_["id"] = value;
+			break;
+		case 4:
+			value = reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()];
+			message.jsonData_["samples"] = (message.jsonData_["samples"] || []).concat(value);
+			break;
+		case 5:
+			value = {key: '', value: 0};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = reader.readInt64();
+						entry.value = __value;
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["totals"] = message.jsonData_["totals"] || {};
+			message.jsonData_["totals"][String(value.key)] = value.value;
+			break;
+		case 6:
+			value = new test.equals.Sample({});
+			reader.readMessage(value, test.equals.Sample.deserializeBinaryFromReader);
+			message.jsonData_["child"] = value.getJsonData();
+			message.child_ = undefined;
+			break;
+		case 7:
+			delete message.jsonData_["number"];
+			value = reader.readString();
+			message.jsonData_["text"] = value;
+			break;
+		case 8:
+			delete message.jsonData_["text"];
+			value = reader.readInt64();
+			message.jsonData_["number"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.equals.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.equals.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.equals.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.equals.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.equals.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.equals.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two floating point numbers, NaN being equal to NaN.
+ * @param {?number} a The first number.
+ * @param {?number} b The second number.
+ * @return {boolean} Whether the numbers are equal.
+ */
+test.equals.floatEquals_ = function(a, b) {
+	return a === b || (a !== a && b !== b);
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.equals.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.equals.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+test.equals.objectEquals_ = function(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.equals.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.equals.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.equals.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.equals.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.equals.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.equals.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.equals.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.equals.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+test.equals.verifyMap_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(test.equals.verifyError_(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+};
+
+/**
+ * Checks that a JSON value is a number.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.equals.verifyNumber_ = function(v, path) {
+	return typeof v === 'number' ? [] : [test.equals.verifyError_(path, 'a number', v)];
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.equals.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.equals.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.equals.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,1263 @@
+// Code generated by protoc-gen-js.
+// source: test/extensions.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+import * as jspb from 'google-protobuf';
+
+/**
+ * @enum {number}
+ */
+export const Level = {
+	LOW: 0,
+	HIGH: 1
+};
+
+/**
+ * The helpers of the Level enum.
+ * @const
+ */
+export const LevelUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		0: 'LOW',
+		1: 'HIGH'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return LevelUtil.isValid(value) ? LevelUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?Level} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(Level, name) ? Level[name] : null;
+	},
+	/**
+	 * @return {!Array.<Level>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [0, 1];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(LevelUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const Extendable = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+Extendable.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+Extendable.TYPE_NAME = 'test.ext.Extendable';
+
+/**
+ * @return {number}
+ */
+Extendable.prototype.getId = function() {
+	var v = this.jsonData_["id"];
+	return v != null ? Number(v) : 0;
+};
+
+/**
+ * @param {number} id The id.
+ */
+Extendable.prototype.setId = function(id) {
+	this.jsonData_["id"] = id;
+};
+
+/**
+ * @return {boolean} Whether the id is set.
+ */
+Extendable.prototype.hasId = function() {
+	return this.jsonData_["id"] != null;
+};
+
+/**
+ * Clears the id.
+ */
+Extendable.prototype.clearId = function() {
+	delete this.jsonData_["id"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+Extendable.prototype.equals = function(other) {
+	if (!(other instanceof Extendable)) {
+		return false;
+	}
+	if (this.getId() !== other.getId()) {
+		return false;
+	}
+	return extensionsEqual(this.jsonData_, other.jsonData_);
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+Extendable.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!Extendable} A copy of the message, sharing no data with it.
+ */
+Extendable.prototype.clone = function() {
+	return new Extendable(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!Extendable} other The other message.
+ */
+Extendable.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["id"];
+	if (other.hasId()) {
+		this.jsonData_["id"] = v;
+	}
+	mergeExtensions(Extendable.extensions, this.jsonData_, other.jsonData_);
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+Extendable.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "id");
+	if (p !== true) {
+		delete this.jsonData_["id"];
+	}
+	pruneExtensions(this.jsonData_, paths);
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!Extendable} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+Extendable.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasId() !== other.hasId() || this.getId() !== other.getId()) {
+		paths.push("id");
+	}
+	diffExtensions(paths, this.jsonData_, other.jsonData_);
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+Extendable.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+Extendable.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["id"], prefix + "id", verifyInteger(32, false, true));
+	return errors;
+};
+
+/**
+ * The descriptors of the extensions of the message, by field number.
+ * @const {!Object.<number, !Object>}
+ */
+Extendable.extensions = {};
+
+/**
+ * @param {{fullName: string, extendee: string, fromJSON: function(*): T}} ext
+ *     The extension.
+ * @return {T} The value of the extension, or its default if unset.
+ * @template T
+ */
+Extendable.prototype.getExtension = function(ext) {
+	return ext.fromJSON(this.jsonData_[extensionKey(Extendable.TYPE_NAME, ext)]);
+};
+
+/**
+ * @param {{fullName: string, extendee: string, toJSON: function(T): *}} ext
+ *     The extension.
+ * @param {T} value The value of the extension.
+ * @template T
+ */
+Extendable.prototype.setExtension = function(ext, value) {
+	this.jsonData_[extensionKey(Extendable.TYPE_NAME, ext)] = ext.toJSON(value);
+};
+
+/**
+ * @param {{fullName: string, extendee: string}} ext The extension.
+ * @return {boolean} Whether the extension is set.
+ */
+Extendable.prototype.hasExtension = function(ext) {
+	return this.jsonData_[extensionKey(Extendable.TYPE_NAME, ext)] != null;
+};
+
+/**
+ * Clears the extension.
+ * @param {{fullName: string, extendee: string}} ext The extension.
+ */
+Extendable.prototype.clearExtension = function(ext) {
+	delete this.jsonData_[extensionKey(Extendable.TYPE_NAME, ext)];
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+Extendable.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	Extendable.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!Extendable} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+Extendable.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["id"];
+	if (v != null) {
+		writer.writeInt32(1, Number(v));
+	}
+	for (var __number in Extendable.extensions) {
+		var __ext = Extendable.extensions[__number];
+		v = message.jsonData_['[' + __ext.fullName + ']'];
+		if (v != null) {
+			__ext.write(v, writer);
+		}
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!Extendable} The message.
+ */
+Extendable.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return Extendable.deserializeBinaryFromReader(new Extendable({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!Extendable} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Extendable} The message.
+ */
+Extendable.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readInt32();
+			message.jsonData_["id"] = value;
+			break;
+		default:
+			var __ext = Extendable.extensions[reader.getFieldNumber()];
+			if (__ext) {
+				var __key = '[' + __ext.fullName + ']';
+				message.jsonData_[__key] = __ext.read(reader, message.jsonData_[__key]);
+			} else {
+				reader.skipField();
+			}
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const Note = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+Note.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+Note.TYPE_NAME = 'test.ext.Note';
+
+/**
+ * @return {string}
+ */
+Note.prototype.getText = function() {
+	var v = this.jsonData_["text"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} text The text.
+ */
+Note.prototype.setText = function(text) {
+	this.jsonData_["text"] = text;
+};
+
+/**
+ * @return {boolean} Whether the text is set.
+ */
+Note.prototype.hasText = function() {
+	return this.jsonData_["text"] != null;
+};
+
+/**
+ * Clears the text.
+ */
+Note.prototype.clearText = function() {
+	delete this.jsonData_["text"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+Note.prototype.equals = function(other) {
+	if (!(other instanceof Note)) {
+		return false;
+	}
+	if (this.getText() !== other.getText()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+Note.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!Note} A copy of the message, sharing no data with it.
+ */
+Note.prototype.clone = function() {
+	return new Note(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!Note} other The other message.
+ */
+Note.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["text"];
+	if (other.hasText()) {
+		this.jsonData_["text"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+Note.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "text");
+	if (p !== true) {
+		delete this.jsonData_["text"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!Note} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+Note.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasText() !== other.hasText() || this.getText() !== other.getText()) {
+		paths.push("text");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+Note.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+Note.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["text"], prefix + "text", verifyString);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+Note.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	Note.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!Note} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+Note.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["text"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!Note} The message.
+ */
+Note.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return Note.deserializeBinaryFromReader(new Note({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!Note} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Note} The message.
+ */
+Note.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["text"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const Scope = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+Scope.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+Scope.TYPE_NAME = 'test.ext.Scope';
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+Scope.prototype.equals = function(other) {
+	if (!(other instanceof Scope)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+Scope.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!Scope} A copy of the message, sharing no data with it.
+ */
+Scope.prototype.clone = function() {
+	return new Scope(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!Scope} other The other message.
+ */
+Scope.prototype.mergeFrom = function(other) {
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+Scope.prototype.applyFieldMask = function(paths) {
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!Scope} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+Scope.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+Scope.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+Scope.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+Scope.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	Scope.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!Scope} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+Scope.serializeBinaryToWriter = function(message, writer) {
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!Scope} The message.
+ */
+Scope.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return Scope.deserializeBinaryFromReader(new Scope({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!Scope} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Scope} The message.
+ */
+Scope.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * The priority.
+ *
+ * The priority extension of test.ext.Extendable.
+ * @const
+ */
+export const priority = {
+	fieldNumber: 100,
+	fullName: 'test.ext.priority',
+	extendee: 'test.ext.Extendable',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {number} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? Number(v) : 3;
+	},
+	/**
+	 * @param {number} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeInt32(100, Number(v));
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readInt32();
+		v = value;
+		return v;
+	}
+};
+Extendable.extensions[100] = priority;
+
+/**
+ * The notes extension of test.ext.Extendable.
+ * @const
+ */
+export const notes = {
+	fieldNumber: 101,
+	fullName: 'test.ext.notes',
+	extendee: 'test.ext.Extendable',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {Array.<Note>} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return (v || []).map(function(__item) {
+			return new Note(__item);
+		});
+	},
+	/**
+	 * @param {Array.<Note>} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v.map(function(__item) {
+			return __item.getJsonData();
+		});
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		v.forEach(function(__item) {
+			writer.writeMessage(101, new Note(__item), Note.serializeBinaryToWriter);
+		}, this);
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = new Note({});
+		reader.readMessage(value, Note.deserializeBinaryFromReader);
+		v = v || [];
+		v.push(value.getJsonData());
+		return v;
+	}
+};
+Extendable.extensions[101] = notes;
+
+/**
+ * The level extension of test.ext.Extendable.
+ * @const
+ */
+export const level = {
+	fieldNumber: 103,
+	fullName: 'test.ext.level',
+	extendee: 'test.ext.Extendable',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {Level} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? enumFromJSON(LevelUtil, v, Level.LOW) : Level.LOW;
+	},
+	/**
+	 * @param {Level} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return (LevelUtil.nameOf(v) || v);
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeEnum(103, enumFromJSON(LevelUtil, v, Level.LOW));
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readEnum();
+		v = (LevelUtil.nameOf(value) || value);
+		return v;
+	}
+};
+Extendable.extensions[103] = level;
+
+/**
+ * The extensions extension of test.ext.Extendable.
+ * @const
+ */
+Extendable.extensions_ = {
+	fieldNumber: 105,
+	fullName: 'test.ext.Extendable.extensions',
+	extendee: 'test.ext.Extendable',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {number} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? Number(v) : 0;
+	},
+	/**
+	 * @param {number} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeInt32(105, Number(v));
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readInt32();
+		v = value;
+		return v;
+	}
+};
+Extendable.extensions[105] = Extendable.extensions_;
+
+/**
+ * The note extension of test.ext.Extendable.
+ * @const
+ */
+Scope.note = {
+	fieldNumber: 102,
+	fullName: 'test.ext.Scope.note',
+	extendee: 'test.ext.Extendable',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {Note} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? new Note(v) : undefined;
+	},
+	/**
+	 * @param {Note} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v.getJsonData();
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeMessage(102, new Note(v), Note.serializeBinaryToWriter);
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = new Note({});
+		reader.readMessage(value, Note.deserializeBinaryFromReader);
+		v = value.getJsonData();
+		return v;
+	}
+};
+Extendable.extensions[102] = Scope.note;
+
+/**
+ * The verify extension of test.ext.Extendable.
+ * @const
+ */
+Scope.verify_ = {
+	fieldNumber: 104,
+	fullName: 'test.ext.Scope.verify',
+	extendee: 'test.ext.Extendable',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {string} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? v : '';
+	},
+	/**
+	 * @param {string} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspb.BinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeString(104, v);
+	},
+	/**
+	 * @param {!jspb.BinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readString();
+		v = value;
+		return v;
+	}
+};
+Extendable.extensions[104] = Scope.verify_;
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+function copyJSON(v) {
+	if (Array.isArray(v)) {
+		return v.map(copyJSON);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = copyJSON(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+}
+
+/**
+ * Appends the keys of the extensions differing in the JSON data of two
+ * messages, the keys in brackets, to the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {!Object} a The JSON data of the first message.
+ * @param {!Object} b The JSON data of the second message.
+ */
+function diffExtensions(paths, a, b) {
+	var keys = Object.keys(a).concat(Object.keys(b));
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (key.charAt(0) === '[' && paths.indexOf(key) < 0 && !jsonEquals(a[key], b[key])) {
+			paths.push(key);
+		}
+	}
+}
+
+/**
+ * Converts an enum value from its canonical JSON form, the name of the value
+ * or its number, maybe as a decimal string, into its number.
+ * @param {{valueOf: function(string): ?number}} util The helpers of the enum.
+ * @param {*} v The JSON value.
+ * @param {number} def The default of the field, for the names the enum doesn't
+ *     define.
+ * @return {number} The number.
+ */
+function enumFromJSON(util, v, def) {
+	if (typeof v !== 'string') {
+		return /** @type {number} */ (v);
+	}
+	if (/^-?[0-9]+$/.test(v)) {
+		return Number(v);
+	}
+	var n = util.valueOf(v);
+	return n !== null ? n : def;
+}
+
+/**
+ * Returns the key of the value of the extension in the JSON data of the
+ * extended messages, the full name of the extension in brackets.
+ * @param {string} typeName The full name of the type of the message.
+ * @param {{fullName: string, extendee: string}} ext The extension.
+ * @return {string} The key.
+ */
+function extensionKey(typeName, ext) {
+	if (ext.extendee !== typeName) {
+		throw new Error(ext.fullName + ' does not extend ' + typeName);
+	}
+	return '[' + ext.fullName + ']';
+}
+
+/**
+ * Compares the extensions in the JSON data of two messages, the keys in
+ * brackets.
+ * @param {!Object} a The JSON data of the first message.
+ * @param {!Object} b The JSON data of the second message.
+ * @return {boolean} Whether the extensions are equal.
+ */
+function extensionsEqual(a, b) {
+	var keys = Object.keys(a).concat(Object.keys(b));
+	for (var i = 0; i < keys.length; i++) {
+		if (keys[i].charAt(0) === '[' && !jsonEquals(a[keys[i]], b[keys[i]])) {
+			return false;
+		}
+	}
+	return true;
+}
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+function fieldMaskPaths(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+}
+
+/**
+ * Compares two JSON values deeply, NaN being equal to NaN.
+ * @param {*} a The first value.
+ * @param {*} b The second value.
+ * @return {boolean} Whether the values are equal.
+ */
+function jsonEquals(a, b) {
+	if (a === b || (a !== a && b !== b)) {
+		return true;
+	}
+	if (a === null || b === null || typeof a !== 'object' || typeof b !== 'object' ||
+			Array.isArray(a) !== Array.isArray(b)) {
+		return false;
+	}
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		if (!Object.prototype.hasOwnProperty.call(b, keys[i]) || !jsonEquals(a[keys[i]], b[keys[i]])) {
+			return false;
+		}
+	}
+	return true;
+}
+
+/**
+ * Merges the extensions in the JSON data of a message, the keys in
+ * brackets, into the JSON data of another message of the same type. The values
+ * of the repeated extensions are appended, the messages of the registered
+ * extensions are merged and the other values overwrite the previous ones.
+ * @param {!Object.<number, !Object>} extensions The registered extensions of the
+ *     type of the messages.
+ * @param {!Object} to The JSON data of the message merged into.
+ * @param {!Object} from The JSON data of the merged message.
+ */
+function mergeExtensions(extensions, to, from) {
+	var byName = {};
+	for (var number in extensions) {
+		byName['[' + extensions[number].fullName + ']'] = extensions[number];
+	}
+	for (var key in from) {
+		var v = from[key];
+		if (key.charAt(0) !== '[' || v == null) {
+			continue;
+		}
+		if (Array.isArray(v)) {
+			to[key] = (to[key] || []).concat(copyJSON(v));
+			continue;
+		}
+		var ext = byName[key];
+		var value = ext && to[key] != null ? ext.fromJSON(to[key]) : null;
+		if (value && typeof value.mergeFrom === 'function') {
+			value.mergeFrom(ext.fromJSON(v));
+		} else {
+			to[key] = copyJSON(v);
+		}
+	}
+}
+
+/**
+ * Deletes the extensions in the JSON data of a message, the keys in
+ * brackets, missing from the paths of a field mask. The paths of the extensions
+ * are their keys, like [foo.bar.baz].
+ * @param {!Object} data The JSON data.
+ * @param {!Array.<string>} paths The paths.
+ */
+function pruneExtensions(data, paths) {
+	for (var key in data) {
+		if (key.charAt(0) === '[' && paths.indexOf(key) < 0) {
+			delete data[key];
+		}
+	}
+}
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyDecimal(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[verifyError(path, expected, v)];
+	};
+}
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+function verifyError(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+}
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyInteger(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return verifyDecimal(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[verifyError(path, expected, v)];
+	};
+}
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+function verifyString(v, path) {
+	return typeof v === 'string' ? [] : [verifyError(path, 'a string', v)];
+}
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+function verifyValue(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+}
+
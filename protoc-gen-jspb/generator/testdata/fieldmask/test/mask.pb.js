@@ -0,0 +1,1299 @@
+// Code generated by protoc-gen-js.
+// source: test/mask.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.mask.Leaf');
+goog.provide('test.mask.Branch');
+goog.provide('test.mask.Tree');
+
+goog.require('goog.array');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.mask.Leaf = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.mask.Leaf.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.mask.Leaf.TYPE_NAME = 'test.mask.Leaf';
+
+/**
+ * @return {string}
+ */
+test.mask.Leaf.prototype.getValue = function() {
+	var v = this.jsonData_["value"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} value The value.
+ */
+test.mask.Leaf.prototype.setValue = function(value) {
+	this.jsonData_["value"] = value;
+};
+
+/**
+ * @return {boolean} Whether the value is set to a value other than the default.
+ */
+test.mask.Leaf.prototype.hasValue = function() {
+	return this.getValue() !== '';
+};
+
+/**
+ * Clears the value.
+ */
+test.mask.Leaf.prototype.clearValue = function() {
+	delete this.jsonData_["value"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.mask.Leaf.prototype.equals = function(other) {
+	if (!(other instanceof test.mask.Leaf)) {
+		return false;
+	}
+	if (this.getValue() !== other.getValue()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.mask.Leaf.prototype.deepCopy = function() {
+	return test.mask.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.mask.Leaf} A copy of the message, sharing no data with it.
+ */
+test.mask.Leaf.prototype.clone = function() {
+	return new test.mask.Leaf(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.mask.Leaf} other The other message.
+ */
+test.mask.Leaf.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["value"];
+	if (other.hasValue()) {
+		this.jsonData_["value"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.mask.Leaf.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.mask.fieldMaskPaths_(paths, "value");
+	if (p !== true) {
+		delete this.jsonData_["value"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.mask.Leaf} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.mask.Leaf.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getValue() !== other.getValue()) {
+		paths.push("value");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.mask.Leaf.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.mask.Leaf.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.mask.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.mask.verifyValue_(errors, json["value"], prefix + "value", test.mask.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.mask.Leaf.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.mask.Leaf.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.mask.Leaf} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.mask.Leaf.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["value"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.mask.Leaf} The message.
+ */
+test.mask.Leaf.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.mask.Leaf.deserializeBinaryFromReader(new test.mask.Leaf({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.mask.Leaf} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.mask.Leaf} The message.
+ */
+test.mask.Leaf.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["value"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.mask.Branch = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.mask.Branch.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.mask.Branch.TYPE_NAME = 'test.mask.Branch';
+
+/**
+ * @return {test.mask.Leaf}
+ */
+test.mask.Branch.prototype.getLeaf = function() {
+	if (this.leaf_) {
+		return this.leaf_;
+	}
+	var v = this.jsonData_["leaf"];
+	if (v) {
+		/** @private {test.mask.Leaf} */
+		this.leaf_ = new test.mask.Leaf(v);
+		return this.leaf_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.mask.Leaf} leaf The leaf.
+ */
+test.mask.Branch.prototype.setLeaf = function(leaf) {
+	this.jsonData_["leaf"] = leaf.getJsonData();
+	this.leaf_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the leaf is set.
+ */
+test.mask.Branch.prototype.hasLeaf = function() {
+	return this.jsonData_["leaf"] != null;
+};
+
+/**
+ * Clears the leaf.
+ */
+test.mask.Branch.prototype.clearLeaf = function() {
+	delete this.jsonData_["leaf"];
+	this.leaf_ = undefined;
+};
+
+/**
+ * @return {string}
+ */
+test.mask.Branch.prototype.getLabel = function() {
+	var v = this.jsonData_["label"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} label The label.
+ */
+test.mask.Branch.prototype.setLabel = function(label) {
+	this.jsonData_["label"] = label;
+};
+
+/**
+ * @return {boolean} Whether the label is set to a value other than the default.
+ */
+test.mask.Branch.prototype.hasLabel = function() {
+	return this.getLabel() !== '';
+};
+
+/**
+ * Clears the label.
+ */
+test.mask.Branch.prototype.clearLabel = function() {
+	delete this.jsonData_["label"];
+};
+
+/**
+ * @return {Array.<test.mask.Leaf>}
+ */
+test.mask.Branch.prototype.getLeaves = function() {
+	if (this.leaves_) {
+		return this.leaves_;
+	}
+	var v = this.jsonData_["leaves"];
+	if (v) {
+		/** @private {Array.<test.mask.Leaf>} */
+		this.leaves_ = [];
+		goog.array.forEach(v, function(__item, __index) {
+			this.leaves_.push(new test.mask.Leaf(__item));
+		}, this);
+		return this.leaves_;
+	}
+	return [];
+};
+
+/**
+ * @param {Array.<test.mask.Leaf>} leaves The leaves.
+ */
+test.mask.Branch.prototype.setLeaves = function(leaves) {
+	this.jsonData_["leaves"] = (leaves || []).map(function(__item) {
+		return __item.getJsonData();
+	});
+	this.leaves_ = undefined;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.mask.Branch.prototype.equals = function(other) {
+	if (!(other instanceof test.mask.Branch)) {
+		return false;
+	}
+	if (!test.mask.messageEquals_(this.getLeaf(), other.getLeaf())) {
+		return false;
+	}
+	if (this.getLabel() !== other.getLabel()) {
+		return false;
+	}
+	if (!test.mask.arrayEquals_(this.getLeaves(), other.getLeaves(), test.mask.messageEquals_)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.mask.Branch.prototype.deepCopy = function() {
+	return test.mask.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.mask.Branch} A copy of the message, sharing no data with it.
+ */
+test.mask.Branch.prototype.clone = function() {
+	return new test.mask.Branch(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.mask.Branch} other The other message.
+ */
+test.mask.Branch.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["leaf"];
+	if (other.hasLeaf()) {
+		if (this.hasLeaf()) {
+			this.getLeaf().mergeFrom(other.getLeaf());
+		} else {
+			this.jsonData_["leaf"] = test.mask.copyJSON_(v);
+		}
+		this.leaf_ = undefined;
+	}
+	v = other.jsonData_["label"];
+	if (other.hasLabel()) {
+		this.jsonData_["label"] = v;
+	}
+	v = other.jsonData_["leaves"];
+	if (v != null && v.length) {
+		this.jsonData_["leaves"] = (this.jsonData_["leaves"] || []).concat(test.mask.copyJSON_(v));
+		this.leaves_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.mask.Branch.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.mask.fieldMaskPaths_(paths, "leaf");
+	if (p !== true) {
+		if (p.length && this.hasLeaf()) {
+			this.getLeaf().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["leaf"];
+			this.leaf_ = undefined;
+		}
+	}
+	p = test.mask.fieldMaskPaths_(paths, "label");
+	if (p !== true) {
+		delete this.jsonData_["label"];
+	}
+	p = test.mask.fieldMaskPaths_(paths, "leaves");
+	if (p !== true) {
+		delete this.jsonData_["leaves"];
+		this.leaves_ = undefined;
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.mask.Branch} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.mask.Branch.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasLeaf() && other.hasLeaf()) {
+		test.mask.appendPaths_(paths, "leaf.", this.getLeaf().diffFieldMask(other.getLeaf()));
+	} else if (this.hasLeaf() !== other.hasLeaf()) {
+		paths.push("leaf");
+	}
+	if (this.getLabel() !== other.getLabel()) {
+		paths.push("label");
+	}
+	if (!test.mask.arrayEquals_(this.getLeaves(), other.getLeaves(), test.mask.messageEquals_)) {
+		paths.push("leaves");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.mask.Branch.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasLeaf()) {
+		v = this.getLeaf();
+		test.mask.nestedViolations_(violations, "leaf", v.validate());
+	}
+	v = this.getLeaves();
+	goog.array.forEach(v, function(__item, __index) {
+		test.mask.nestedViolations_(violations, "leaves[" + __index + "]", __item.validate());
+	}, this);
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.mask.Branch.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.mask.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.mask.verifyValue_(errors, json["leaf"], prefix + "leaf", test.mask.Leaf.verify);
+	test.mask.verifyValue_(errors, json["label"], prefix + "label", test.mask.verifyString_);
+	test.mask.verifyArray_(errors, json["leaves"], prefix + "leaves", test.mask.Leaf.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.mask.Branch.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.mask.Branch.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.mask.Branch} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.mask.Branch.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["leaf"];
+	if (v != null) {
+		writer.writeMessage(1, new test.mask.Leaf(v), test.mask.Leaf.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["label"];
+	if (v != null) {
+		writer.writeString(2, v);
+	}
+	v = message.jsonData_["leaves"];
+	if (v != null) {
+		goog.array.forEach(v, function(__item) {
+			writer.writeMessage(3, new test.mask.Leaf(__item), test.mask.Leaf.serializeBinaryToWriter);
+		}, this);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.mask.Branch} The message.
+ */
+test.mask.Branch.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.mask.Branch.deserializeBinaryFromReader(new test.mask.Branch({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.mask.Branch} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.mask.Branch} The message.
+ */
+test.mask.Branch.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new test.mask.Leaf({});
+			reader.readMessage(value, test.mask.Leaf.deserializeBinaryFromReader);
+			message.jsonData_["leaf"] = value.getJsonData();
+			message.leaf_ = undefined;
+			break;
+		case 2:
+			value = reader.readString();
+			message.jsonData_["label"] = value;
+			break;
+		case 3:
+			value = new test.mask.Leaf({});
+			reader.readMessage(value, test.mask.Leaf.deserializeBinaryFromReader);
+			message.jsonData_["leaves"] = message.jsonData_["leaves"] || [];
+			message.jsonData_["leaves"].push(value.getJsonData());
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.mask.Tree = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.mask.Tree.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.mask.Tree.TYPE_NAME = 'test.mask.Tree';
+
+/**
+ * @return {test.mask.Branch}
+ */
+test.mask.Tree.prototype.getBranch = function() {
+	if (this.branch_) {
+		return this.branch_;
+	}
+	var v = this.jsonData_["branch"];
+	if (v) {
+		/** @private {test.mask.Branch} */
+		this.branch_ = new test.mask.Branch(v);
+		return this.branch_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.mask.Branch} branch The branch.
+ */
+test.mask.Tree.prototype.setBranch = function(branch) {
+	this.jsonData_["branch"] = branch.getJsonData();
+	this.branch_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the branch is set.
+ */
+test.mask.Tree.prototype.hasBranch = function() {
+	return this.jsonData_["branch"] != null;
+};
+
+/**
+ * Clears the branch.
+ */
+test.mask.Tree.prototype.clearBranch = function() {
+	delete this.jsonData_["branch"];
+	this.branch_ = undefined;
+};
+
+/**
+ * @return {test.mask.Branch}
+ */
+test.mask.Tree.prototype.getOther = function() {
+	if (this.other_) {
+		return this.other_;
+	}
+	var v = this.jsonData_["other"];
+	if (v) {
+		/** @private {test.mask.Branch} */
+		this.other_ = new test.mask.Branch(v);
+		return this.other_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.mask.Branch} other The other.
+ */
+test.mask.Tree.prototype.setOther = function(other) {
+	this.jsonData_["other"] = other.getJsonData();
+	this.other_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the other is set.
+ */
+test.mask.Tree.prototype.hasOther = function() {
+	return this.jsonData_["other"] != null;
+};
+
+/**
+ * Clears the other.
+ */
+test.mask.Tree.prototype.clearOther = function() {
+	delete this.jsonData_["other"];
+	this.other_ = undefined;
+};
+
+/**
+ * @return {string}
+ */
+test.mask.Tree.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+test.mask.Tree.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set to a value other than the default.
+ */
+test.mask.Tree.prototype.hasName = function() {
+	return this.getName() !== '';
+};
+
+/**
+ * Clears the name.
+ */
+test.mask.Tree.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * @return {Object.<string, test.mask.Leaf>}
+ */
+test.mask.Tree.prototype.getByName = function() {
+	if (this.by_name_) {
+		return this.by_name_;
+	}
+	var v = this.jsonData_["by_name"];
+	if (v) {
+		/** @private {Object.<string, test.mask.Leaf>} */
+		this.by_name_ = {};
+		for (var __key in v) {
+			this.by_name_[__key] = new test.mask.Leaf(v[__key]);
+		}
+		return this.by_name_;
+	}
+	return {};
+};
+
+/**
+ * @param {Object.<string, test.mask.Leaf>} by_name The by_name.
+ */
+test.mask.Tree.prototype.setByName = function(by_name) {
+	var __data = {};
+	for (var __key in by_name) {
+		__data[__key] = by_name[__key].getJsonData();
+	}
+	this.jsonData_["by_name"] = __data;
+	this.by_name_ = undefined;
+};
+
+/**
+ * @return {!Map.<string, test.mask.Leaf>}
+ */
+test.mask.Tree.prototype.getByNameMap = function() {
+	var __map = new Map();
+	var __obj = this.getByName();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, test.mask.Leaf>} by_name The by_name.
+ */
+test.mask.Tree.prototype.setByNameMap = function(by_name) {
+	var __obj = {};
+	by_name.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setByName(__obj);
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.mask.Tree.prototype.equals = function(other) {
+	if (!(other instanceof test.mask.Tree)) {
+		return false;
+	}
+	if (!test.mask.messageEquals_(this.getBranch(), other.getBranch())) {
+		return false;
+	}
+	if (!test.mask.messageEquals_(this.getOther(), other.getOther())) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	if (!test.mask.objectEquals_(this.getByName(), other.getByName(), test.mask.messageEquals_)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.mask.Tree.prototype.deepCopy = function() {
+	return test.mask.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.mask.Tree} A copy of the message, sharing no data with it.
+ */
+test.mask.Tree.prototype.clone = function() {
+	return new test.mask.Tree(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.mask.Tree} other The other message.
+ */
+test.mask.Tree.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["branch"];
+	if (other.hasBranch()) {
+		if (this.hasBranch()) {
+			this.getBranch().mergeFrom(other.getBranch());
+		} else {
+			this.jsonData_["branch"] = test.mask.copyJSON_(v);
+		}
+		this.branch_ = undefined;
+	}
+	v = other.jsonData_["other"];
+	if (other.hasOther()) {
+		if (this.hasOther()) {
+			this.getOther().mergeFrom(other.getOther());
+		} else {
+			this.jsonData_["other"] = test.mask.copyJSON_(v);
+		}
+		this.other_ = undefined;
+	}
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+	v = other.jsonData_["by_name"];
+	if (v != null) {
+		this.jsonData_["by_name"] = this.jsonData_["by_name"] || {};
+		for (var __key in v) {
+			this.jsonData_["by_name"][__key] = test.mask.copyJSON_(v[__key]);
+		}
+		this.by_name_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.mask.Tree.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.mask.fieldMaskPaths_(paths, "branch");
+	if (p !== true) {
+		if (p.length && this.hasBranch()) {
+			this.getBranch().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["branch"];
+			this.branch_ = undefined;
+		}
+	}
+	p = test.mask.fieldMaskPaths_(paths, "other");
+	if (p !== true) {
+		if (p.length && this.hasOther()) {
+			this.getOther().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["other"];
+			this.other_ = undefined;
+		}
+	}
+	p = test.mask.fieldMaskPaths_(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+	p = test.mask.fieldMaskPaths_(paths, "by_name");
+	if (p !== true) {
+		delete this.jsonData_["by_name"];
+		this.by_name_ = undefined;
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.mask.Tree} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.mask.Tree.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasBranch() && other.hasBranch()) {
+		test.mask.appendPaths_(paths, "branch.", this.getBranch().diffFieldMask(other.getBranch()));
+	} else if (this.hasBranch() !== other.hasBranch()) {
+		paths.push("branch");
+	}
+	if (this.hasOther() && other.hasOther()) {
+		test.mask.appendPaths_(paths, "other.", this.getOther().diffFieldMask(other.getOther()));
+	} else if (this.hasOther() !== other.hasOther()) {
+		paths.push("other");
+	}
+	if (this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	if (!test.mask.objectEquals_(this.getByName(), other.getByName(), test.mask.messageEquals_)) {
+		paths.push("by_name");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.mask.Tree.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasBranch()) {
+		v = this.getBranch();
+		test.mask.nestedViolations_(violations, "branch", v.validate());
+	}
+	if (this.hasOther()) {
+		v = this.getOther();
+		test.mask.nestedViolations_(violations, "other", v.validate());
+	}
+	v = this.getByName();
+	for (var __key in v) {
+		test.mask.nestedViolations_(violations, "by_name[\"" + __key + "\"]", v[__key].validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.mask.Tree.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.mask.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.mask.verifyValue_(errors, json["branch"], prefix + "branch", test.mask.Branch.verify);
+	test.mask.verifyValue_(errors, json["other"], prefix + "other", test.mask.Branch.verify);
+	test.mask.verifyValue_(errors, json["name"], prefix + "name", test.mask.verifyString_);
+	test.mask.verifyMap_(errors, json["by_name"], prefix + "by_name", test.mask.Leaf.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.mask.Tree.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.mask.Tree.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.mask.Tree} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.mask.Tree.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["branch"];
+	if (v != null) {
+		writer.writeMessage(1, new test.mask.Branch(v), test.mask.Branch.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["other"];
+	if (v != null) {
+		writer.writeMessage(2, new test.mask.Branch(v), test.mask.Branch.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(3, v);
+	}
+	v = message.jsonData_["by_name"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(4, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeMessage(2, new test.mask.Leaf(v[__key]), test.mask.Leaf.serializeBinaryToWriter);
+			});
+		}
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.mask.Tree} The message.
+ */
+test.mask.Tree.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.mask.Tree.deserializeBinaryFromReader(new test.mask.Tree({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.mask.Tree} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.mask.Tree} The message.
+ */
+test.mask.Tree.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new test.mask.Branch({});
+			reader.readMessage(value, test.mask.Branch.deserializeBinaryFromReader);
+			message.jsonData_["branch"] = value.getJsonData();
+			message.branch_ = undefined;
+			break;
+		case 2:
+			value = new test.mask.Branch({});
+			reader.readMessage(value, test.mask.Branch.deserializeBinaryFromReader);
+			message.jsonData_["other"] = value.getJsonData();
+			message.other_ = undefined;
+			break;
+		case 3:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		case 4:
+			value = {key: '', value: {}};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = new test.mask.Leaf({});
+						reader.readMessage(__value, test.mask.Leaf.deserializeBinaryFromReader);
+						entry.value = __value.getJsonData();
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["by_name"] = message.jsonData_["by_name"] || {};
+			message.jsonData_["by_name"][String(value.key)] = value.value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.mask.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.mask.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.mask.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.mask.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.mask.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.mask.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.mask.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.mask.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+test.mask.objectEquals_ = function(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.mask.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.mask.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.mask.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+test.mask.verifyMap_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(test.mask.verifyError_(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.mask.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.mask.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.mask.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,33 @@
+// Code generated by protoc-gen-js.
+// source: test/imports.proto
+// DO NOT EDIT!
+
+declare namespace test.imports {
+	class User {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getDep(): test.dep.Dep;
+		setDep(dep: test.dep.Dep): void;
+		hasDep(): boolean;
+		clearDep(): void;
+		getLevel(): test.dep.Level;
+		setLevel(level: test.dep.Level): void;
+		hasLevel(): boolean;
+		clearLevel(): void;
+		getWrapper(): test.pub.Wrapper;
+		setWrapper(wrapper: test.pub.Wrapper): void;
+		hasWrapper(): boolean;
+		clearWrapper(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): test.imports.User;
+		mergeFrom(other: test.imports.User): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: test.imports.User): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): test.imports.User;
+	}
+}
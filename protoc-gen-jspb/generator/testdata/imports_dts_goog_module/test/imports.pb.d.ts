@@ -0,0 +1,36 @@
+// Code generated by protoc-gen-js.
+// source: test/imports.proto
+// DO NOT EDIT!
+
+declare module 'goog:test.imports.imports' {
+	import * as test_dep_pb from 'goog:test.dep.dep';
+	import * as test_public_pb from 'goog:test.pub.public';
+
+	export class User {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getDep(): test_dep_pb.Dep;
+		setDep(dep: test_dep_pb.Dep): void;
+		hasDep(): boolean;
+		clearDep(): void;
+		getLevel(): test_dep_pb.Level;
+		setLevel(level: test_dep_pb.Level): void;
+		hasLevel(): boolean;
+		clearLevel(): void;
+		getWrapper(): test_public_pb.Wrapper;
+		setWrapper(wrapper: test_public_pb.Wrapper): void;
+		hasWrapper(): boolean;
+		clearWrapper(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): User;
+		mergeFrom(other: User): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: User): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): User;
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-js.
+// source: test/imports.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+import * as jspb from 'google-protobuf';
+import * as test_dep_pb from './dep.pb.js';
+import * as test_public_pb from './public.pb.js';
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const User = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+User.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+User.TYPE_NAME = 'test.imports.User';
+
+/**
+ * @return {test_dep_pb.Dep}
+ */
+User.prototype.getDep = function() {
+	if (this.dep_) {
+		return this.dep_;
+	}
+	var v = this.jsonData_["dep"];
+	if (v) {
+		/** @private {test_dep_pb.Dep} */
+		this.dep_ = new test_dep_pb.Dep(v);
+		return this.dep_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test_dep_pb.Dep} dep The dep.
+ */
+User.prototype.setDep = function(dep) {
+	this.jsonData_["dep"] = dep.getJsonData();
+	this.dep_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the dep is set.
+ */
+User.prototype.hasDep = function() {
+	return this.jsonData_["dep"] != null;
+};
+
+/**
+ * Clears the dep.
+ */
+User.prototype.clearDep = function() {
+	delete this.jsonData_["dep"];
+	this.dep_ = undefined;
+};
+
+/**
+ * @return {test_dep_pb.Level}
+ */
+User.prototype.getLevel = function() {
+	var v = this.jsonData_["level"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {test_dep_pb.Level} level The level.
+ */
+User.prototype.setLevel = function(level) {
+	this.jsonData_["level"] = level;
+};
+
+/**
+ * @return {boolean} Whether the level is set to a value other than the default.
+ */
+User.prototype.hasLevel = function() {
+	return this.getLevel() !== 0;
+};
+
+/**
+ * Clears the level.
+ */
+User.prototype.clearLevel = function() {
+	delete this.jsonData_["level"];
+};
+
+/**
+ * @return {test_public_pb.Wrapper}
+ */
+User.prototype.getWrapper = function() {
+	if (this.wrapper_) {
+		return this.wrapper_;
+	}
+	var v = this.jsonData_["wrapper"];
+	if (v) {
+		/** @private {test_public_pb.Wrapper} */
+		this.wrapper_ = new test_public_pb.Wrapper(v);
+		return this.wrapper_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test_public_pb.Wrapper} wrapper The wrapper.
+ */
+User.prototype.setWrapper = function(wrapper) {
+	this.jsonData_["wrapper"] = wrapper.getJsonData();
+	this.wrapper_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the wrapper is set.
+ */
+User.prototype.hasWrapper = function() {
+	return this.jsonData_["wrapper"] != null;
+};
+
+/**
+ * Clears the wrapper.
+ */
+User.prototype.clearWrapper = function() {
+	delete this.jsonData_["wrapper"];
+	this.wrapper_ = undefined;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+User.prototype.equals = function(other) {
+	if (!(other instanceof User)) {
+		return false;
+	}
+	if (!messageEquals(this.getDep(), other.getDep())) {
+		return false;
+	}
+	if (this.getLevel() !== other.getLevel()) {
+		return false;
+	}
+	if (!messageEquals(this.getWrapper(), other.getWrapper())) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+User.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!User} A copy of the message, sharing no data with it.
+ */
+User.prototype.clone = function() {
+	return new User(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!User} other The other message.
+ */
+User.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["dep"];
+	if (other.hasDep()) {
+		if (this.hasDep()) {
+			this.getDep().mergeFrom(other.getDep());
+		} else {
+			this.jsonData_["dep"] = copyJSON(v);
+		}
+		this.dep_ = undefined;
+	}
+	v = other.jsonData_["level"];
+	if (other.hasLevel()) {
+		this.jsonData_["level"] = v;
+	}
+	v = other.jsonData_["wrapper"];
+	if (other.hasWrapper()) {
+		if (this.hasWrapper()) {
+			this.getWrapper().mergeFrom(other.getWrapper());
+		} else {
+			this.jsonData_["wrapper"] = copyJSON(v);
+		}
+		this.wrapper_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+User.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "dep");
+	if (p !== true) {
+		if (p.length && this.hasDep()) {
+			this.getDep().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["dep"];
+			this.dep_ = undefined;
+		}
+	}
+	p = fieldMaskPaths(paths, "level");
+	if (p !== true) {
+		delete this.jsonData_["level"];
+	}
+	p = fieldMaskPaths(paths, "wrapper");
+	if (p !== true) {
+		if (p.length && this.hasWrapper()) {
+			this.getWrapper().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["wrapper"];
+			this.wrapper_ = undefined;
+		}
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!User} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+User.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasDep() && other.hasDep()) {
+		appendPaths(paths, "dep.", this.getDep().diffFieldMask(other.getDep()));
+	} else if (this.hasDep() !== other.hasDep()) {
+		paths.push("dep");
+	}
+	if (this.getLevel() !== other.getLevel()) {
+		paths.push("level");
+	}
+	if (this.hasWrapper() && other.hasWrapper()) {
+		appendPaths(paths, "wrapper.", this.getWrapper().diffFieldMask(other.getWrapper()));
+	} else if (this.hasWrapper() !== other.hasWrapper()) {
+		paths.push("wrapper");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+User.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasDep()) {
+		v = this.getDep();
+		nestedViolations(violations, "dep", v.validate());
+	}
+	if (this.hasWrapper()) {
+		v = this.getWrapper();
+		nestedViolations(violations, "wrapper", v.validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+User.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["dep"], prefix + "dep", test_dep_pb.Dep.verify);
+	verifyValue(errors, json["level"], prefix + "level", verifyEnum(test_dep_pb.LevelUtil, false, false));
+	verifyValue(errors, json["wrapper"], prefix + "wrapper", test_public_pb.Wrapper.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+User.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	User.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!User} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+User.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["dep"];
+	if (v != null) {
+		writer.writeMessage(1, new test_dep_pb.Dep(v), test_dep_pb.Dep.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["level"];
+	if (v != null) {
+		writer.writeEnum(2, v);
+	}
+	v = message.jsonData_["wrapper"];
+	if (v != null) {
+		writer.writeMessage(3, new test_public_pb.Wrapper(v), test_public_pb.Wrapper.serializeBinaryToWriter);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!User} The message.
+ */
+User.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return User.deserializeBinaryFromReader(new User({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!User} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!User} The message.
+ */
+User.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new test_dep_pb.Dep({});
+			reader.readMessage(value, test_dep_pb.Dep.deserializeBinaryFromReader);
+			message.jsonData_["dep"] = value.getJsonData();
+			message.dep_ = undefined;
+			break;
+		case 2:
+			value = reader.readEnum();
+			message.jsonData_["level"] = value;
+			break;
+		case 3:
+			value = new test_public_pb.Wrapper({});
+			reader.readMessage(value, test_public_pb.Wrapper.deserializeBinaryFromReader);
+			message.jsonData_["wrapper"] = value.getJsonData();
+			message.wrapper_ = undefined;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+function appendPaths(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+}
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+function copyJSON(v) {
+	if (Array.isArray(v)) {
+		return v.map(copyJSON);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = copyJSON(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+}
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+function fieldMaskPaths(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+}
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+function messageEquals(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+}
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+function nestedViolations(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+}
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyEnum(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[verifyError(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [verifyError(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [verifyError(path, 'a value of the enum', v)];
+	};
+}
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+function verifyError(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+}
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+function verifyValue(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+}
+
@@ -0,0 +1,686 @@
+// Code generated by protoc-gen-js.
+// source: test/int64.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.int64.Counter');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.int64.Counter = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.int64.Counter.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.int64.Counter.TYPE_NAME = 'test.int64.Counter';
+
+/**
+ * @return {string}
+ */
+test.int64.Counter.prototype.getId = function() {
+	var v = this.jsonData_["id"];
+	return v != null ? String(v) : '0';
+};
+
+/**
+ * @param {string} id The id.
+ */
+test.int64.Counter.prototype.setId = function(id) {
+	this.jsonData_["id"] = id;
+};
+
+/**
+ * @return {boolean} Whether the id is set to a value other than the default.
+ */
+test.int64.Counter.prototype.hasId = function() {
+	return this.getId() !== '0';
+};
+
+/**
+ * Clears the id.
+ */
+test.int64.Counter.prototype.clearId = function() {
+	delete this.jsonData_["id"];
+};
+
+/**
+ * @return {number}
+ */
+test.int64.Counter.prototype.getSmall = function() {
+	var v = this.jsonData_["small"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} small The small.
+ */
+test.int64.Counter.prototype.setSmall = function(small) {
+	this.jsonData_["small"] = small;
+};
+
+/**
+ * @return {boolean} Whether the small is set to a value other than the default.
+ */
+test.int64.Counter.prototype.hasSmall = function() {
+	return this.getSmall() !== 0;
+};
+
+/**
+ * Clears the small.
+ */
+test.int64.Counter.prototype.clearSmall = function() {
+	delete this.jsonData_["small"];
+};
+
+/**
+ * @return {string}
+ */
+test.int64.Counter.prototype.getTotal = function() {
+	var v = this.jsonData_["total"];
+	return v != null ? String(v) : '0';
+};
+
+/**
+ * @param {string} total The total.
+ */
+test.int64.Counter.prototype.setTotal = function(total) {
+	this.jsonData_["total"] = total;
+};
+
+/**
+ * @return {boolean} Whether the total is set to a value other than the default.
+ */
+test.int64.Counter.prototype.hasTotal = function() {
+	return this.getTotal() !== '0';
+};
+
+/**
+ * Clears the total.
+ */
+test.int64.Counter.prototype.clearTotal = function() {
+	delete this.jsonData_["total"];
+};
+
+/**
+ * @return {Array.<string>}
+ */
+test.int64.Counter.prototype.getDeltas = function() {
+	return (this.jsonData_["deltas"] || []).map(function(__v) {
+		return String(__v);
+	});
+};
+
+/**
+ * @param {Array.<string>} deltas The deltas.
+ */
+test.int64.Counter.prototype.setDeltas = function(deltas) {
+	this.jsonData_["deltas"] = deltas;
+};
+
+/**
+ * @return {Object.<string, string>}
+ */
+test.int64.Counter.prototype.getLabels = function() {
+	return this.jsonData_["labels"] || {};
+};
+
+/**
+ * @param {Object.<string, string>} labels The labels.
+ */
+test.int64.Counter.prototype.setLabels = function(labels) {
+	this.jsonData_["labels"] = labels;
+};
+
+/**
+ * @return {!Map.<string, string>}
+ */
+test.int64.Counter.prototype.getLabelsMap = function() {
+	var __map = new Map();
+	var __obj = this.getLabels();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, string>} labels The labels.
+ */
+test.int64.Counter.prototype.setLabelsMap = function(labels) {
+	var __obj = {};
+	labels.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setLabels(__obj);
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.int64.Counter.prototype.equals = function(other) {
+	if (!(other instanceof test.int64.Counter)) {
+		return false;
+	}
+	if (this.getId() !== other.getId()) {
+		return false;
+	}
+	if (this.getSmall() !== other.getSmall()) {
+		return false;
+	}
+	if (this.getTotal() !== other.getTotal()) {
+		return false;
+	}
+	if (!test.int64.arrayEquals_(this.getDeltas(), other.getDeltas(), null)) {
+		return false;
+	}
+	if (!test.int64.objectEquals_(this.getLabels(), other.getLabels(), null)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.int64.Counter.prototype.deepCopy = function() {
+	return test.int64.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.int64.Counter} A copy of the message, sharing no data with it.
+ */
+test.int64.Counter.prototype.clone = function() {
+	return new test.int64.Counter(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.int64.Counter} other The other message.
+ */
+test.int64.Counter.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["id"];
+	if (other.hasId()) {
+		this.jsonData_["id"] = v;
+	}
+	v = other.jsonData_["small"];
+	if (other.hasSmall()) {
+		this.jsonData_["small"] = v;
+	}
+	v = other.jsonData_["total"];
+	if (other.hasTotal()) {
+		this.jsonData_["total"] = v;
+	}
+	v = other.jsonData_["deltas"];
+	if (v != null && v.length) {
+		this.jsonData_["deltas"] = (this.jsonData_["deltas"] || []).concat(v);
+	}
+	v = other.jsonData_["labels"];
+	if (v != null) {
+		this.jsonData_["labels"] = this.jsonData_["labels"] || {};
+		for (var __key in v) {
+			this.jsonData_["labels"][__key] = test.int64.copyJSON_(v[__key]);
+		}
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.int64.Counter.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.int64.fieldMaskPaths_(paths, "id");
+	if (p !== true) {
+		delete this.jsonData_["id"];
+	}
+	p = test.int64.fieldMaskPaths_(paths, "small");
+	if (p !== true) {
+		delete this.jsonData_["small"];
+	}
+	p = test.int64.fieldMaskPaths_(paths, "total");
+	if (p !== true) {
+		delete this.jsonData_["total"];
+	}
+	p = test.int64.fieldMaskPaths_(paths, "deltas");
+	if (p !== true) {
+		delete this.jsonData_["deltas"];
+	}
+	p = test.int64.fieldMaskPaths_(paths, "labels");
+	if (p !== true) {
+		delete this.jsonData_["labels"];
+		this.labels_ = undefined;
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.int64.Counter} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.int64.Counter.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getId() !== other.getId()) {
+		paths.push("id");
+	}
+	if (this.getSmall() !== other.getSmall()) {
+		paths.push("small");
+	}
+	if (this.getTotal() !== other.getTotal()) {
+		paths.push("total");
+	}
+	if (!test.int64.arrayEquals_(this.getDeltas(), other.getDeltas(), null)) {
+		paths.push("deltas");
+	}
+	if (!test.int64.objectEquals_(this.getLabels(), other.getLabels(), null)) {
+		paths.push("labels");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.int64.Counter.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.int64.Counter.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.int64.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.int64.verifyValue_(errors, json["id"], prefix + "id", test.int64.verifyDecimal_(false));
+	test.int64.verifyValue_(errors, json["small"], prefix + "small", test.int64.verifyInteger_(64, false, false));
+	test.int64.verifyValue_(errors, json["total"], prefix + "total", test.int64.verifyDecimal_(true));
+	test.int64.verifyArray_(errors, json["deltas"], prefix + "deltas", test.int64.verifyDecimal_(false));
+	test.int64.verifyMap_(errors, json["labels"], prefix + "labels", test.int64.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.int64.Counter.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.int64.Counter.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.int64.Counter} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.int64.Counter.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["id"];
+	if (v != null) {
+		writer.writeInt64String(1, String(v));
+	}
+	v = message.jsonData_["small"];
+	if (v != null) {
+		writer.writeInt64(2, v);
+	}
+	v = message.jsonData_["total"];
+	if (v != null) {
+		writer.writeFixed64String(3, String(v));
+	}
+	v = message.jsonData_["deltas"];
+	if (v != null) {
+		v = v.map(function(__v) {
+			return String(__v);
+		});
+		writer.writePackedSint64String(4, v);
+	}
+	v = message.jsonData_["labels"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(5, __key, function(__key, writer) {
+				writer.writeUint64String(1, __key);
+				writer.writeString(2, v[__key]);
+			});
+		}
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.int64.Counter} The message.
+ */
+test.int64.Counter.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.int64.Counter.deserializeBinaryFromReader(new test.int64.Counter({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.int64.Counter} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.int64.Counter} The message.
+ */
+test.int64.Counter.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readInt64String();
+			message.jsonData_["id"] = value;
+			break;
+		case 2:
+			value = reader.readInt64();
+			message.jsonData_["small"] = value;
+			break;
+		case 3:
+			value = reader.readFixed64String();
+			message.jsonData_["total"] = value;
+			break;
+		case 4:
+			value = reader.isDelimited() ? reader.readPackedSint64String() : [reader.readSint64String()];
+			message.jsonData_["deltas"] = (message.jsonData_["deltas"] || []).concat(value);
+			break;
+		case 5:
+			value = {key: '0', value: ''};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readUint64String();
+						break;
+					case 2:
+						__value = reader.readString();
+						entry.value = __value;
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["labels"] = message.jsonData_["labels"] || {};
+			message.jsonData_["labels"][String(value.key)] = value.value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.int64.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.int64.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.int64.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.int64.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.int64.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.int64.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+test.int64.objectEquals_ = function(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.int64.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.int64.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.int64.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.int64.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.int64.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.int64.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.int64.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.int64.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+test.int64.verifyMap_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(test.int64.verifyError_(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.int64.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.int64.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.int64.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,797 @@
+// Code generated by protoc-gen-js.
+// source: test/maps.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.maps.Inventory');
+goog.provide('test.maps.Item');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.maps.Inventory = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.maps.Inventory.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.maps.Inventory.TYPE_NAME = 'test.maps.Inventory';
+
+/**
+ * @return {Object.<string, number>}
+ */
+test.maps.Inventory.prototype.getCounts = function() {
+	return this.jsonData_["counts"] || {};
+};
+
+/**
+ * @param {Object.<string, number>} counts The counts.
+ */
+test.maps.Inventory.prototype.setCounts = function(counts) {
+	this.jsonData_["counts"] = counts;
+};
+
+/**
+ * @return {!Map.<string, number>}
+ */
+test.maps.Inventory.prototype.getCountsMap = function() {
+	var __map = new Map();
+	var __obj = this.getCounts();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, number>} counts The counts.
+ */
+test.maps.Inventory.prototype.setCountsMap = function(counts) {
+	var __obj = {};
+	counts.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setCounts(__obj);
+};
+
+/**
+ * @return {Object.<string, test.maps.Item>}
+ */
+test.maps.Inventory.prototype.getItems = function() {
+	if (this.items_) {
+		return this.items_;
+	}
+	var v = this.jsonData_["items"];
+	if (v) {
+		/** @private {Object.<string, test.maps.Item>} */
+		this.items_ = {};
+		for (var __key in v) {
+			this.items_[__key] = new test.maps.Item(v[__key]);
+		}
+		return this.items_;
+	}
+	return {};
+};
+
+/**
+ * @param {Object.<string, test.maps.Item>} items The items.
+ */
+test.maps.Inventory.prototype.setItems = function(items) {
+	var __data = {};
+	for (var __key in items) {
+		__data[__key] = items[__key].getJsonData();
+	}
+	this.jsonData_["items"] = __data;
+	this.items_ = undefined;
+};
+
+/**
+ * @return {!Map.<number, test.maps.Item>}
+ */
+test.maps.Inventory.prototype.getItemsMap = function() {
+	var __map = new Map();
+	var __obj = this.getItems();
+	for (var __key in __obj) {
+		__map.set(Number(__key), __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<number, test.maps.Item>} items The items.
+ */
+test.maps.Inventory.prototype.setItemsMap = function(items) {
+	var __obj = {};
+	items.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setItems(__obj);
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.maps.Inventory.prototype.equals = function(other) {
+	if (!(other instanceof test.maps.Inventory)) {
+		return false;
+	}
+	if (!test.maps.objectEquals_(this.getCounts(), other.getCounts(), null)) {
+		return false;
+	}
+	if (!test.maps.objectEquals_(this.getItems(), other.getItems(), test.maps.messageEquals_)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.maps.Inventory.prototype.deepCopy = function() {
+	return test.maps.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.maps.Inventory} A copy of the message, sharing no data with it.
+ */
+test.maps.Inventory.prototype.clone = function() {
+	return new test.maps.Inventory(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.maps.Inventory} other The other message.
+ */
+test.maps.Inventory.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["counts"];
+	if (v != null) {
+		this.jsonData_["counts"] = this.jsonData_["counts"] || {};
+		for (var __key in v) {
+			this.jsonData_["counts"][__key] = test.maps.copyJSON_(v[__key]);
+		}
+	}
+	v = other.jsonData_["items"];
+	if (v != null) {
+		this.jsonData_["items"] = this.jsonData_["items"] || {};
+		for (var __key in v) {
+			this.jsonData_["items"][__key] = test.maps.copyJSON_(v[__key]);
+		}
+		this.items_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.maps.Inventory.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.maps.fieldMaskPaths_(paths, "counts");
+	if (p !== true) {
+		delete this.jsonData_["counts"];
+		this.counts_ = undefined;
+	}
+	p = test.maps.fieldMaskPaths_(paths, "items");
+	if (p !== true) {
+		delete this.jsonData_["items"];
+		this.items_ = undefined;
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.maps.Inventory} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.maps.Inventory.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (!test.maps.objectEquals_(this.getCounts(), other.getCounts(), null)) {
+		paths.push("counts");
+	}
+	if (!test.maps.objectEquals_(this.getItems(), other.getItems(), test.maps.messageEquals_)) {
+		paths.push("items");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.maps.Inventory.prototype.validate = function() {
+	var violations = [];
+	var v;
+	v = this.getItems();
+	for (var __key in v) {
+		test.maps.nestedViolations_(violations, "items[\"" + __key + "\"]", v[__key].validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.maps.Inventory.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.maps.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.maps.verifyMap_(errors, json["counts"], prefix + "counts", test.maps.verifyInteger_(32, false, false));
+	test.maps.verifyMap_(errors, json["items"], prefix + "items", test.maps.Item.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.maps.Inventory.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.maps.Inventory.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.maps.Inventory} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.maps.Inventory.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["counts"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(1, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeInt32(2, v[__key]);
+			});
+		}
+	}
+	v = message.jsonData_["items"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(2, __key, function(__key, writer) {
+				writer.writeInt64(1, Number(__key));
+				writer.writeMessage(2, new test.maps.Item(v[__key]), test.maps.Item.serializeBinaryToWriter);
+			});
+		}
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.maps.Inventory} The message.
+ */
+test.maps.Inventory.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.maps.Inventory.deserializeBinaryFromReader(new test.maps.Inventory({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.maps.Inventory} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.maps.Inventory} The message.
+ */
+test.maps.Inventory.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = {key: '', value: 0};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = reader.readInt32();
+						entry.value = __value;
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["counts"] = message.jsonData_["counts"] || {};
+			message.jsonData_["counts"][String(value.key)] = value.value;
+			break;
+		case 2:
+			value = {key: 0, value: {}};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readInt64();
+						break;
+					case 2:
+						__value = new test.maps.Item({});
+						reader.readMessage(__value, test.maps.Item.deserializeBinaryFromReader);
+						entry.value = __value.getJsonData();
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["items"] = message.jsonData_["items"] || {};
+			message.jsonData_["items"][String(value.key)] = value.value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.maps.Item = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.maps.Item.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.maps.Item.TYPE_NAME = 'test.maps.Item';
+
+/**
+ * @return {string}
+ */
+test.maps.Item.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+test.maps.Item.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set to a value other than the default.
+ */
+test.maps.Item.prototype.hasName = function() {
+	return this.getName() !== '';
+};
+
+/**
+ * Clears the name.
+ */
+test.maps.Item.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.maps.Item.prototype.equals = function(other) {
+	if (!(other instanceof test.maps.Item)) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.maps.Item.prototype.deepCopy = function() {
+	return test.maps.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.maps.Item} A copy of the message, sharing no data with it.
+ */
+test.maps.Item.prototype.clone = function() {
+	return new test.maps.Item(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.maps.Item} other The other message.
+ */
+test.maps.Item.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.maps.Item.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.maps.fieldMaskPaths_(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.maps.Item} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.maps.Item.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.maps.Item.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.maps.Item.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.maps.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.maps.verifyValue_(errors, json["name"], prefix + "name", test.maps.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.maps.Item.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.maps.Item.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.maps.Item} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.maps.Item.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.maps.Item} The message.
+ */
+test.maps.Item.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.maps.Item.deserializeBinaryFromReader(new test.maps.Item({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.maps.Item} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.maps.Item} The message.
+ */
+test.maps.Item.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.maps.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.maps.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.maps.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.maps.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.maps.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.maps.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+test.maps.objectEquals_ = function(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.maps.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.maps.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.maps.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.maps.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.maps.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.maps.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+test.maps.verifyMap_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(test.maps.verifyError_(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.maps.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.maps.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.maps.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
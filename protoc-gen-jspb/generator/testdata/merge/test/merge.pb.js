@@ -0,0 +1,1354 @@
+// Code generated by protoc-gen-js.
+// source: test/merge.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.merge.Part');
+goog.provide('test.merge.Assembly');
+
+goog.require('goog.array');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.merge.Part = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.merge.Part.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.merge.Part.TYPE_NAME = 'test.merge.Part';
+
+/**
+ * @return {string}
+ */
+test.merge.Part.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+test.merge.Part.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set to a value other than the default.
+ */
+test.merge.Part.prototype.hasName = function() {
+	return this.getName() !== '';
+};
+
+/**
+ * Clears the name.
+ */
+test.merge.Part.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * @return {Array.<string>}
+ */
+test.merge.Part.prototype.getTags = function() {
+	return this.jsonData_["tags"] || [];
+};
+
+/**
+ * @param {Array.<string>} tags The tags.
+ */
+test.merge.Part.prototype.setTags = function(tags) {
+	this.jsonData_["tags"] = tags;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.merge.Part.prototype.equals = function(other) {
+	if (!(other instanceof test.merge.Part)) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	if (!test.merge.arrayEquals_(this.getTags(), other.getTags(), null)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.merge.Part.prototype.deepCopy = function() {
+	return test.merge.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.merge.Part} A copy of the message, sharing no data with it.
+ */
+test.merge.Part.prototype.clone = function() {
+	return new test.merge.Part(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.merge.Part} other The other message.
+ */
+test.merge.Part.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+	v = other.jsonData_["tags"];
+	if (v != null && v.length) {
+		this.jsonData_["tags"] = (this.jsonData_["tags"] || []).concat(v);
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.merge.Part.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.merge.fieldMaskPaths_(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+	p = test.merge.fieldMaskPaths_(paths, "tags");
+	if (p !== true) {
+		delete this.jsonData_["tags"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.merge.Part} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.merge.Part.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	if (!test.merge.arrayEquals_(this.getTags(), other.getTags(), null)) {
+		paths.push("tags");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.merge.Part.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.merge.Part.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.merge.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.merge.verifyValue_(errors, json["name"], prefix + "name", test.merge.verifyString_);
+	test.merge.verifyArray_(errors, json["tags"], prefix + "tags", test.merge.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.merge.Part.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.merge.Part.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.merge.Part} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.merge.Part.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+	v = message.jsonData_["tags"];
+	if (v != null) {
+		writer.writeRepeatedString(2, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.merge.Part} The message.
+ */
+test.merge.Part.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.merge.Part.deserializeBinaryFromReader(new test.merge.Part({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.merge.Part} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.merge.Part} The message.
+ */
+test.merge.Part.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		case 2:
+			value = [reader.readString()];
+			message.jsonData_["tags"] = (message.jsonData_["tags"] || []).concat(value);
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.merge.Assembly = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.merge.Assembly.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.merge.Assembly.TYPE_NAME = 'test.merge.Assembly';
+
+/**
+ * @return {string}
+ */
+test.merge.Assembly.prototype.getText = function() {
+	var v = this.jsonData_["text"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} text The text.
+ */
+test.merge.Assembly.prototype.setText = function(text) {
+	this.clearSource();
+	this.jsonData_["text"] = text;
+};
+
+/**
+ * @return {boolean} Whether the text is set.
+ */
+test.merge.Assembly.prototype.hasText = function() {
+	return this.jsonData_["text"] != null;
+};
+
+/**
+ * Clears the text.
+ */
+test.merge.Assembly.prototype.clearText = function() {
+	delete this.jsonData_["text"];
+};
+
+/**
+ * @return {test.merge.Part}
+ */
+test.merge.Assembly.prototype.getPart = function() {
+	if (this.part_) {
+		return this.part_;
+	}
+	var v = this.jsonData_["part"];
+	if (v) {
+		/** @private {test.merge.Part} */
+		this.part_ = new test.merge.Part(v);
+		return this.part_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.merge.Part} part The part.
+ */
+test.merge.Assembly.prototype.setPart = function(part) {
+	this.clearSource();
+	this.jsonData_["part"] = part.getJsonData();
+	this.part_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the part is set.
+ */
+test.merge.Assembly.prototype.hasPart = function() {
+	return this.jsonData_["part"] != null;
+};
+
+/**
+ * Clears the part.
+ */
+test.merge.Assembly.prototype.clearPart = function() {
+	delete this.jsonData_["part"];
+	this.part_ = undefined;
+};
+
+/**
+ * @return {number}
+ */
+test.merge.Assembly.prototype.getCount = function() {
+	var v = this.jsonData_["count"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} count The count.
+ */
+test.merge.Assembly.prototype.setCount = function(count) {
+	this.clearSource();
+	this.jsonData_["count"] = count;
+};
+
+/**
+ * @return {boolean} Whether the count is set.
+ */
+test.merge.Assembly.prototype.hasCount = function() {
+	return this.jsonData_["count"] != null;
+};
+
+/**
+ * Clears the count.
+ */
+test.merge.Assembly.prototype.clearCount = function() {
+	delete this.jsonData_["count"];
+};
+
+/**
+ * @return {Array.<test.merge.Part>}
+ */
+test.merge.Assembly.prototype.getParts = function() {
+	if (this.parts_) {
+		return this.parts_;
+	}
+	var v = this.jsonData_["parts"];
+	if (v) {
+		/** @private {Array.<test.merge.Part>} */
+		this.parts_ = [];
+		goog.array.forEach(v, function(__item, __index) {
+			this.parts_.push(new test.merge.Part(__item));
+		}, this);
+		return this.parts_;
+	}
+	return [];
+};
+
+/**
+ * @param {Array.<test.merge.Part>} parts The parts.
+ */
+test.merge.Assembly.prototype.setParts = function(parts) {
+	this.jsonData_["parts"] = (parts || []).map(function(__item) {
+		return __item.getJsonData();
+	});
+	this.parts_ = undefined;
+};
+
+/**
+ * @return {Array.<number>}
+ */
+test.merge.Assembly.prototype.getSizes = function() {
+	return this.jsonData_["sizes"] || [];
+};
+
+/**
+ * @param {Array.<number>} sizes The sizes.
+ */
+test.merge.Assembly.prototype.setSizes = function(sizes) {
+	this.jsonData_["sizes"] = sizes;
+};
+
+/**
+ * @return {Object.<string, test.merge.Part>}
+ */
+test.merge.Assembly.prototype.getByName = function() {
+	if (this.by_name_) {
+		return this.by_name_;
+	}
+	var v = this.jsonData_["by_name"];
+	if (v) {
+		/** @private {Object.<string, test.merge.Part>} */
+		this.by_name_ = {};
+		for (var __key in v) {
+			this.by_name_[__key] = new test.merge.Part(v[__key]);
+		}
+		return this.by_name_;
+	}
+	return {};
+};
+
+/**
+ * @param {Object.<string, test.merge.Part>} by_name The by_name.
+ */
+test.merge.Assembly.prototype.setByName = function(by_name) {
+	var __data = {};
+	for (var __key in by_name) {
+		__data[__key] = by_name[__key].getJsonData();
+	}
+	this.jsonData_["by_name"] = __data;
+	this.by_name_ = undefined;
+};
+
+/**
+ * @return {!Map.<string, test.merge.Part>}
+ */
+test.merge.Assembly.prototype.getByNameMap = function() {
+	var __map = new Map();
+	var __obj = this.getByName();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, test.merge.Part>} by_name The by_name.
+ */
+test.merge.Assembly.prototype.setByNameMap = function(by_name) {
+	var __obj = {};
+	by_name.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setByName(__obj);
+};
+
+/**
+ * @return {Object.<string, number>}
+ */
+test.merge.Assembly.prototype.getCounts = function() {
+	return this.jsonData_["counts"] || {};
+};
+
+/**
+ * @param {Object.<string, number>} counts The counts.
+ */
+test.merge.Assembly.prototype.setCounts = function(counts) {
+	this.jsonData_["counts"] = counts;
+};
+
+/**
+ * @return {!Map.<string, number>}
+ */
+test.merge.Assembly.prototype.getCountsMap = function() {
+	var __map = new Map();
+	var __obj = this.getCounts();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, number>} counts The counts.
+ */
+test.merge.Assembly.prototype.setCountsMap = function(counts) {
+	var __obj = {};
+	counts.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setCounts(__obj);
+};
+
+/**
+ * @return {test.merge.Part}
+ */
+test.merge.Assembly.prototype.getMain = function() {
+	if (this.main_) {
+		return this.main_;
+	}
+	var v = this.jsonData_["main"];
+	if (v) {
+		/** @private {test.merge.Part} */
+		this.main_ = new test.merge.Part(v);
+		return this.main_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.merge.Part} main The main.
+ */
+test.merge.Assembly.prototype.setMain = function(main) {
+	this.jsonData_["main"] = main.getJsonData();
+	this.main_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the main is set.
+ */
+test.merge.Assembly.prototype.hasMain = function() {
+	return this.jsonData_["main"] != null;
+};
+
+/**
+ * Clears the main.
+ */
+test.merge.Assembly.prototype.clearMain = function() {
+	delete this.jsonData_["main"];
+	this.main_ = undefined;
+};
+
+/**
+ * @return {string}
+ */
+test.merge.Assembly.prototype.getLabel = function() {
+	var v = this.jsonData_["label"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} label The label.
+ */
+test.merge.Assembly.prototype.setLabel = function(label) {
+	this.jsonData_["label"] = label;
+};
+
+/**
+ * @return {boolean} Whether the label is set to a value other than the default.
+ */
+test.merge.Assembly.prototype.hasLabel = function() {
+	return this.getLabel() !== '';
+};
+
+/**
+ * Clears the label.
+ */
+test.merge.Assembly.prototype.clearLabel = function() {
+	delete this.jsonData_["label"];
+};
+
+/**
+ * @enum {number}
+ */
+test.merge.Assembly.SourceCase = {
+	SOURCE_NOT_SET: 0,
+	TEXT: 1,
+	PART: 2,
+	COUNT: 3
+};
+
+/**
+ * @return {test.merge.Assembly.SourceCase} The case of the source oneof.
+ */
+test.merge.Assembly.prototype.getSourceCase = function() {
+	if (this.jsonData_["text"] != null) {
+		return test.merge.Assembly.SourceCase.TEXT;
+	}
+	if (this.jsonData_["part"] != null) {
+		return test.merge.Assembly.SourceCase.PART;
+	}
+	if (this.jsonData_["count"] != null) {
+		return test.merge.Assembly.SourceCase.COUNT;
+	}
+	return test.merge.Assembly.SourceCase.SOURCE_NOT_SET;
+};
+
+/**
+ * Clears all members of the source oneof.
+ */
+test.merge.Assembly.prototype.clearSource = function() {
+	delete this.jsonData_["text"];
+	delete this.jsonData_["part"];
+	this.part_ = undefined;
+	delete this.jsonData_["count"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.merge.Assembly.prototype.equals = function(other) {
+	if (!(other instanceof test.merge.Assembly)) {
+		return false;
+	}
+	if (this.getText() !== other.getText()) {
+		return false;
+	}
+	if (!test.merge.messageEquals_(this.getPart(), other.getPart())) {
+		return false;
+	}
+	if (this.getCount() !== other.getCount()) {
+		return false;
+	}
+	if (!test.merge.arrayEquals_(this.getParts(), other.getParts(), test.merge.messageEquals_)) {
+		return false;
+	}
+	if (!test.merge.arrayEquals_(this.getSizes(), other.getSizes(), null)) {
+		return false;
+	}
+	if (!test.merge.objectEquals_(this.getByName(), other.getByName(), test.merge.messageEquals_)) {
+		return false;
+	}
+	if (!test.merge.objectEquals_(this.getCounts(), other.getCounts(), null)) {
+		return false;
+	}
+	if (!test.merge.messageEquals_(this.getMain(), other.getMain())) {
+		return false;
+	}
+	if (this.getLabel() !== other.getLabel()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.merge.Assembly.prototype.deepCopy = function() {
+	return test.merge.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.merge.Assembly} A copy of the message, sharing no data with it.
+ */
+test.merge.Assembly.prototype.clone = function() {
+	return new test.merge.Assembly(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.merge.Assembly} other The other message.
+ */
+test.merge.Assembly.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["text"];
+	if (other.hasText()) {
+		this.clearSource();
+		this.jsonData_["text"] = v;
+	}
+	v = other.jsonData_["part"];
+	if (other.hasPart()) {
+		if (this.hasPart()) {
+			this.getPart().mergeFrom(other.getPart());
+		} else {
+			this.clearSource();
+			this.jsonData_["part"] = test.merge.copyJSON_(v);
+		}
+		this.part_ = undefined;
+	}
+	v = other.jsonData_["count"];
+	if (other.hasCount()) {
+		this.clearSource();
+		this.jsonData_["count"] = v;
+	}
+	v = other.jsonData_["parts"];
+	if (v != null && v.length) {
+		this.jsonData_["parts"] = (this.jsonData_["parts"] || []).concat(test.merge.copyJSON_(v));
+		this.parts_ = undefined;
+	}
+	v = other.jsonData_["sizes"];
+	if (v != null && v.length) {
+		this.jsonData_["sizes"] = (this.jsonData_["sizes"] || []).concat(v);
+	}
+	v = other.jsonData_["by_name"];
+	if (v != null) {
+		this.jsonData_["by_name"] = this.jsonData_["by_name"] || {};
+		for (var __key in v) {
+			this.jsonData_["by_name"][__key] = test.merge.copyJSON_(v[__key]);
+		}
+		this.by_name_ = undefined;
+	}
+	v = other.jsonData_["counts"];
+	if (v != null) {
+		this.jsonData_["counts"] = this.jsonData_["counts"] || {};
+		for (var __key in v) {
+			this.jsonData_["counts"][__key] = test.merge.copyJSON_(v[__key]);
+		}
+	}
+	v = other.jsonData_["main"];
+	if (other.hasMain()) {
+		if (this.hasMain()) {
+			this.getMain().mergeFrom(other.getMain());
+		} else {
+			this.jsonData_["main"] = test.merge.copyJSON_(v);
+		}
+		this.main_ = undefined;
+	}
+	v = other.jsonData_["label"];
+	if (other.hasLabel()) {
+		this.jsonData_["label"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.merge.Assembly.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.merge.fieldMaskPaths_(paths, "text");
+	if (p !== true) {
+		delete this.jsonData_["text"];
+	}
+	p = test.merge.fieldMaskPaths_(paths, "part");
+	if (p !== true) {
+		if (p.length && this.hasPart()) {
+			this.getPart().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["part"];
+			this.part_ = undefined;
+		}
+	}
+	p = test.merge.fieldMaskPaths_(paths, "count");
+	if (p !== true) {
+		delete this.jsonData_["count"];
+	}
+	p = test.merge.fieldMaskPaths_(paths, "parts");
+	if (p !== true) {
+		delete this.jsonData_["parts"];
+		this.parts_ = undefined;
+	}
+	p = test.merge.fieldMaskPaths_(paths, "sizes");
+	if (p !== true) {
+		delete this.jsonData_["sizes"];
+	}
+	p = test.merge.fieldMaskPaths_(paths, "by_name");
+	if (p !== true) {
+		delete this.jsonData_["by_name"];
+		this.by_name_ = undefined;
+	}
+	p = test.merge.fieldMaskPaths_(paths, "counts");
+	if (p !== true) {
+		delete this.jsonData_["counts"];
+		this.counts_ = undefined;
+	}
+	p = test.merge.fieldMaskPaths_(paths, "main");
+	if (p !== true) {
+		if (p.length && this.hasMain()) {
+			this.getMain().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["main"];
+			this.main_ = undefined;
+		}
+	}
+	p = test.merge.fieldMaskPaths_(paths, "label");
+	if (p !== true) {
+		delete this.jsonData_["label"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.merge.Assembly} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.merge.Assembly.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasText() !== other.hasText() || this.getText() !== other.getText()) {
+		paths.push("text");
+	}
+	if (this.hasPart() && other.hasPart()) {
+		test.merge.appendPaths_(paths, "part.", this.getPart().diffFieldMask(other.getPart()));
+	} else if (this.hasPart() !== other.hasPart()) {
+		paths.push("part");
+	}
+	if (this.hasCount() !== other.hasCount() || this.getCount() !== other.getCount()) {
+		paths.push("count");
+	}
+	if (!test.merge.arrayEquals_(this.getParts(), other.getParts(), test.merge.messageEquals_)) {
+		paths.push("parts");
+	}
+	if (!test.merge.arrayEquals_(this.getSizes(), other.getSizes(), null)) {
+		paths.push("sizes");
+	}
+	if (!test.merge.objectEquals_(this.getByName(), other.getByName(), test.merge.messageEquals_)) {
+		paths.push("by_name");
+	}
+	if (!test.merge.objectEquals_(this.getCounts(), other.getCounts(), null)) {
+		paths.push("counts");
+	}
+	if (this.hasMain() && other.hasMain()) {
+		test.merge.appendPaths_(paths, "main.", this.getMain().diffFieldMask(other.getMain()));
+	} else if (this.hasMain() !== other.hasMain()) {
+		paths.push("main");
+	}
+	if (this.getLabel() !== other.getLabel()) {
+		paths.push("label");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.merge.Assembly.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasPart()) {
+		v = this.getPart();
+		test.merge.nestedViolations_(violations, "part", v.validate());
+	}
+	v = this.getParts();
+	goog.array.forEach(v, function(__item, __index) {
+		test.merge.nestedViolations_(violations, "parts[" + __index + "]", __item.validate());
+	}, this);
+	v = this.getByName();
+	for (var __key in v) {
+		test.merge.nestedViolations_(violations, "by_name[\"" + __key + "\"]", v[__key].validate());
+	}
+	if (this.hasMain()) {
+		v = this.getMain();
+		test.merge.nestedViolations_(violations, "main", v.validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.merge.Assembly.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.merge.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.merge.verifyValue_(errors, json["text"], prefix + "text", test.merge.verifyString_);
+	test.merge.verifyValue_(errors, json["part"], prefix + "part", test.merge.Part.verify);
+	test.merge.verifyValue_(errors, json["count"], prefix + "count", test.merge.verifyInteger_(32, false, false));
+	test.merge.verifyArray_(errors, json["parts"], prefix + "parts", test.merge.Part.verify);
+	test.merge.verifyArray_(errors, json["sizes"], prefix + "sizes", test.merge.verifyInteger_(32, false, false));
+	test.merge.verifyMap_(errors, json["by_name"], prefix + "by_name", test.merge.Part.verify);
+	test.merge.verifyMap_(errors, json["counts"], prefix + "counts", test.merge.verifyInteger_(32, false, false));
+	test.merge.verifyValue_(errors, json["main"], prefix + "main", test.merge.Part.verify);
+	test.merge.verifyValue_(errors, json["label"], prefix + "label", test.merge.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.merge.Assembly.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.merge.Assembly.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.merge.Assembly} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.merge.Assembly.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["text"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+	v = message.jsonData_["part"];
+	if (v != null) {
+		writer.writeMessage(2, new test.merge.Part(v), test.merge.Part.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["count"];
+	if (v != null) {
+		writer.writeInt32(3, v);
+	}
+	v = message.jsonData_["parts"];
+	if (v != null) {
+		goog.array.forEach(v, function(__item) {
+			writer.writeMessage(4, new test.merge.Part(__item), test.merge.Part.serializeBinaryToWriter);
+		}, this);
+	}
+	v = message.jsonData_["sizes"];
+	if (v != null) {
+		writer.writePackedInt32(5, v);
+	}
+	v = message.jsonData_["by_name"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(6, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeMessage(2, new test.merge.Part(v[__key]), test.merge.Part.serializeBinaryToWriter);
+			});
+		}
+	}
+	v = message.jsonData_["counts"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(7, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeInt32(2, v[__key]);
+			});
+		}
+	}
+	v = message.jsonData_["main"];
+	if (v != null) {
+		writer.writeMessage(8, new test.merge.Part(v), test.merge.Part.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["label"];
+	if (v != null) {
+		writer.writeString(9, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.merge.Assembly} The message.
+ */
+test.merge.Assembly.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.merge.Assembly.deserializeBinaryFromReader(new test.merge.Assembly({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.merge.Assembly} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.merge.Assembly} The message.
+ */
+test.merge.Assembly.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			delete message.jsonData_["part"];
+			message.part_ = undefined;
+			delete message.jsonData_["count"];
+			value = reader.readString();
+			message.jsonData_["text"] = value;
+			break;
+		case 2:
+			delete message.jsonData_["text"];
+			delete message.jsonData_["count"];
+			value = new test.merge.Part({});
+			reader.readMessage(value, test.merge.Part.deserializeBinaryFromReader);
+			message.jsonData_["part"] = value.getJsonData();
+			message.part_ = undefined;
+			break;
+		case 3:
+			delete message.jsonData_["text"];
+			delete message.jsonData_["part"];
+			message.part_ = undefined;
+			value = reader.readInt32();
+			message.jsonData_["count"] = value;
+			break;
+		case 4:
+			value = new test.merge.Part({});
+			reader.readMessage(value, test.merge.Part.deserializeBinaryFromReader);
+			message.jsonData_["parts"] = message.jsonData_["parts"] || [];
+			message.jsonData_["parts"].push(value.getJsonData());
+			break;
+		case 5:
+			value = reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()];
+			message.jsonData_["sizes"] = (message.jsonData_["sizes"] || []).concat(value);
+			break;
+		case 6:
+			value = {key: '', value: {}};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = new test.merge.Part({});
+						reader.readMessage(__value, test.merge.Part.deserializeBinaryFromReader);
+						entry.value = __value.getJsonData();
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["by_name"] = message.jsonData_["by_name"] || {};
+			message.jsonData_["by_name"][String(value.key)] = value.value;
+			break;
+		case 7:
+			value = {key: '', value: 0};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = reader.readInt32();
+						entry.value = __value;
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["counts"] = message.jsonData_["counts"] || {};
+			message.jsonData_["counts"][String(value.key)] = value.value;
+			break;
+		case 8:
+			value = new test.merge.Part({});
+			reader.readMessage(value, test.merge.Part.deserializeBinaryFromReader);
+			message.jsonData_["main"] = value.getJsonData();
+			message.main_ = undefined;
+			break;
+		case 9:
+			value = reader.readString();
+			message.jsonData_["label"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.merge.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.merge.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.merge.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.merge.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.merge.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.merge.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.merge.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.merge.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+test.merge.objectEquals_ = function(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.merge.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.merge.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.merge.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.merge.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.merge.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.merge.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.merge.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.merge.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+test.merge.verifyMap_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(test.merge.verifyError_(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.merge.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.merge.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.merge.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,750 @@
+// Code generated by protoc-gen-js.
+// source: test/nested.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.nested.Outer');
+goog.provide('test.nested.Outer_Inner');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * An outer message.
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.nested.Outer = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.nested.Outer.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.nested.Outer.TYPE_NAME = 'test.nested.Outer';
+
+/**
+ * @return {test.nested.Outer_Inner}
+ */
+test.nested.Outer.prototype.getInner = function() {
+	if (this.inner_) {
+		return this.inner_;
+	}
+	var v = this.jsonData_["inner"];
+	if (v) {
+		/** @private {test.nested.Outer_Inner} */
+		this.inner_ = new test.nested.Outer_Inner(v);
+		return this.inner_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.nested.Outer_Inner} inner The inner.
+ */
+test.nested.Outer.prototype.setInner = function(inner) {
+	this.jsonData_["inner"] = inner.getJsonData();
+	this.inner_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the inner is set.
+ */
+test.nested.Outer.prototype.hasInner = function() {
+	return this.jsonData_["inner"] != null;
+};
+
+/**
+ * Clears the inner.
+ */
+test.nested.Outer.prototype.clearInner = function() {
+	delete this.jsonData_["inner"];
+	this.inner_ = undefined;
+};
+
+/**
+ * @return {string}
+ */
+test.nested.Outer.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+test.nested.Outer.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set.
+ */
+test.nested.Outer.prototype.hasName = function() {
+	return this.jsonData_["name"] != null;
+};
+
+/**
+ * Clears the name.
+ */
+test.nested.Outer.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * @return {string}
+ */
+test.nested.Outer.prototype.getJsonData_ = function() {
+	var v = this.jsonData_["json_data"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} json_data The json_data.
+ */
+test.nested.Outer.prototype.setJsonData_ = function(json_data) {
+	this.jsonData_["json_data"] = json_data;
+};
+
+/**
+ * @return {boolean} Whether the json_data is set.
+ */
+test.nested.Outer.prototype.hasJsonData = function() {
+	return this.jsonData_["json_data"] != null;
+};
+
+/**
+ * Clears the json_data.
+ */
+test.nested.Outer.prototype.clearJsonData = function() {
+	delete this.jsonData_["json_data"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.nested.Outer.prototype.equals = function(other) {
+	if (!(other instanceof test.nested.Outer)) {
+		return false;
+	}
+	if (!test.nested.messageEquals_(this.getInner(), other.getInner())) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	if (this.getJsonData_() !== other.getJsonData_()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.nested.Outer.prototype.deepCopy = function() {
+	return test.nested.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.nested.Outer} A copy of the message, sharing no data with it.
+ */
+test.nested.Outer.prototype.clone = function() {
+	return new test.nested.Outer(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.nested.Outer} other The other message.
+ */
+test.nested.Outer.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["inner"];
+	if (other.hasInner()) {
+		if (this.hasInner()) {
+			this.getInner().mergeFrom(other.getInner());
+		} else {
+			this.jsonData_["inner"] = test.nested.copyJSON_(v);
+		}
+		this.inner_ = undefined;
+	}
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+	v = other.jsonData_["json_data"];
+	if (other.hasJsonData()) {
+		this.jsonData_["json_data"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.nested.Outer.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.nested.fieldMaskPaths_(paths, "inner");
+	if (p !== true) {
+		if (p.length && this.hasInner()) {
+			this.getInner().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["inner"];
+			this.inner_ = undefined;
+		}
+	}
+	p = test.nested.fieldMaskPaths_(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+	p = test.nested.fieldMaskPaths_(paths, "json_data");
+	if (p !== true) {
+		delete this.jsonData_["json_data"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.nested.Outer} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.nested.Outer.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasInner() && other.hasInner()) {
+		test.nested.appendPaths_(paths, "inner.", this.getInner().diffFieldMask(other.getInner()));
+	} else if (this.hasInner() !== other.hasInner()) {
+		paths.push("inner");
+	}
+	if (this.hasName() !== other.hasName() || this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	if (this.hasJsonData() !== other.hasJsonData() || this.getJsonData_() !== other.getJsonData_()) {
+		paths.push("json_data");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.nested.Outer.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasInner()) {
+		v = this.getInner();
+		test.nested.nestedViolations_(violations, "inner", v.validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.nested.Outer.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.nested.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.nested.verifyValue_(errors, json["inner"], prefix + "inner", test.nested.Outer_Inner.verify);
+	test.nested.verifyValue_(errors, json["name"], prefix + "name", test.nested.verifyString_);
+	test.nested.verifyValue_(errors, json["json_data"], prefix + "json_data", test.nested.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.nested.Outer.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.nested.Outer.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.nested.Outer} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.nested.Outer.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["inner"];
+	if (v != null) {
+		writer.writeMessage(1, new test.nested.Outer_Inner(v), test.nested.Outer_Inner.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(2, v);
+	}
+	v = message.jsonData_["json_data"];
+	if (v != null) {
+		writer.writeString(3, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.nested.Outer} The message.
+ */
+test.nested.Outer.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.nested.Outer.deserializeBinaryFromReader(new test.nested.Outer({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.nested.Outer} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.nested.Outer} The message.
+ */
+test.nested.Outer.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new test.nested.Outer_Inner({});
+			reader.readMessage(value, test.nested.Outer_Inner.deserializeBinaryFromReader);
+			message.jsonData_["inner"] = value.getJsonData();
+			message.inner_ = undefined;
+			break;
+		case 2:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		case 3:
+			value = reader.readString();
+			message.jsonData_["json_data"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * An inner message.
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.nested.Outer_Inner = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.nested.Outer_Inner.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.nested.Outer_Inner.TYPE_NAME = 'test.nested.Outer.Inner';
+
+/**
+ * @return {number}
+ */
+test.nested.Outer_Inner.prototype.getDepth = function() {
+	var v = this.jsonData_["depth"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} depth The depth.
+ */
+test.nested.Outer_Inner.prototype.setDepth = function(depth) {
+	this.jsonData_["depth"] = depth;
+};
+
+/**
+ * @return {boolean} Whether the depth is set.
+ */
+test.nested.Outer_Inner.prototype.hasDepth = function() {
+	return this.jsonData_["depth"] != null;
+};
+
+/**
+ * Clears the depth.
+ */
+test.nested.Outer_Inner.prototype.clearDepth = function() {
+	delete this.jsonData_["depth"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.nested.Outer_Inner.prototype.equals = function(other) {
+	if (!(other instanceof test.nested.Outer_Inner)) {
+		return false;
+	}
+	if (this.getDepth() !== other.getDepth()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.nested.Outer_Inner.prototype.deepCopy = function() {
+	return test.nested.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.nested.Outer_Inner} A copy of the message, sharing no data with it.
+ */
+test.nested.Outer_Inner.prototype.clone = function() {
+	return new test.nested.Outer_Inner(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.nested.Outer_Inner} other The other message.
+ */
+test.nested.Outer_Inner.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["depth"];
+	if (other.hasDepth()) {
+		this.jsonData_["depth"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.nested.Outer_Inner.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.nested.fieldMaskPaths_(paths, "depth");
+	if (p !== true) {
+		delete this.jsonData_["depth"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.nested.Outer_Inner} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.nested.Outer_Inner.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasDepth() !== other.hasDepth() || this.getDepth() !== other.getDepth()) {
+		paths.push("depth");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.nested.Outer_Inner.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.nested.Outer_Inner.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.nested.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.nested.verifyValue_(errors, json["depth"], prefix + "depth", test.nested.verifyInteger_(32, false, false));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.nested.Outer_Inner.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.nested.Outer_Inner.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.nested.Outer_Inner} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.nested.Outer_Inner.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["depth"];
+	if (v != null) {
+		writer.writeInt32(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.nested.Outer_Inner} The message.
+ */
+test.nested.Outer_Inner.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.nested.Outer_Inner.deserializeBinaryFromReader(new test.nested.Outer_Inner({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.nested.Outer_Inner} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.nested.Outer_Inner} The message.
+ */
+test.nested.Outer_Inner.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readInt32();
+			message.jsonData_["depth"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.nested.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.nested.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.nested.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.nested.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.nested.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.nested.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.nested.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.nested.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.nested.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.nested.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.nested.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.nested.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.nested.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.nested.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.nested.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.nested.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,902 @@
+// Code generated by protoc-gen-js.
+// source: test/oneofs.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.oneofs.Event');
+goog.provide('test.oneofs.Attachment');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.oneofs.Event = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.oneofs.Event.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.oneofs.Event.TYPE_NAME = 'test.oneofs.Event';
+
+/**
+ * @return {string}
+ */
+test.oneofs.Event.prototype.getId = function() {
+	var v = this.jsonData_["id"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} id The id.
+ */
+test.oneofs.Event.prototype.setId = function(id) {
+	this.jsonData_["id"] = id;
+};
+
+/**
+ * @return {boolean} Whether the id is set to a value other than the default.
+ */
+test.oneofs.Event.prototype.hasId = function() {
+	return this.getId() !== '';
+};
+
+/**
+ * Clears the id.
+ */
+test.oneofs.Event.prototype.clearId = function() {
+	delete this.jsonData_["id"];
+};
+
+/**
+ * @return {string}
+ */
+test.oneofs.Event.prototype.getText = function() {
+	var v = this.jsonData_["text"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} text The text.
+ */
+test.oneofs.Event.prototype.setText = function(text) {
+	this.clearPayload();
+	this.jsonData_["text"] = text;
+};
+
+/**
+ * @return {boolean} Whether the text is set.
+ */
+test.oneofs.Event.prototype.hasText = function() {
+	return this.jsonData_["text"] != null;
+};
+
+/**
+ * Clears the text.
+ */
+test.oneofs.Event.prototype.clearText = function() {
+	delete this.jsonData_["text"];
+};
+
+/**
+ * @return {test.oneofs.Attachment}
+ */
+test.oneofs.Event.prototype.getAttachment = function() {
+	if (this.attachment_) {
+		return this.attachment_;
+	}
+	var v = this.jsonData_["attachment"];
+	if (v) {
+		/** @private {test.oneofs.Attachment} */
+		this.attachment_ = new test.oneofs.Attachment(v);
+		return this.attachment_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.oneofs.Attachment} attachment The attachment.
+ */
+test.oneofs.Event.prototype.setAttachment = function(attachment) {
+	this.clearPayload();
+	this.jsonData_["attachment"] = attachment.getJsonData();
+	this.attachment_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the attachment is set.
+ */
+test.oneofs.Event.prototype.hasAttachment = function() {
+	return this.jsonData_["attachment"] != null;
+};
+
+/**
+ * Clears the attachment.
+ */
+test.oneofs.Event.prototype.clearAttachment = function() {
+	delete this.jsonData_["attachment"];
+	this.attachment_ = undefined;
+};
+
+/**
+ * @return {number}
+ */
+test.oneofs.Event.prototype.getSize = function() {
+	var v = this.jsonData_["size"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} size The size.
+ */
+test.oneofs.Event.prototype.setSize = function(size) {
+	this.clearPayload();
+	this.jsonData_["size"] = size;
+};
+
+/**
+ * @return {boolean} Whether the size is set.
+ */
+test.oneofs.Event.prototype.hasSize = function() {
+	return this.jsonData_["size"] != null;
+};
+
+/**
+ * Clears the size.
+ */
+test.oneofs.Event.prototype.clearSize = function() {
+	delete this.jsonData_["size"];
+};
+
+/**
+ * @return {string}
+ */
+test.oneofs.Event.prototype.getNote = function() {
+	var v = this.jsonData_["note"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} note The note.
+ */
+test.oneofs.Event.prototype.setNote = function(note) {
+	this.jsonData_["note"] = note;
+};
+
+/**
+ * @return {boolean} Whether the note is set.
+ */
+test.oneofs.Event.prototype.hasNote = function() {
+	return this.jsonData_["note"] != null;
+};
+
+/**
+ * Clears the note.
+ */
+test.oneofs.Event.prototype.clearNote = function() {
+	delete this.jsonData_["note"];
+};
+
+/**
+ * @enum {number}
+ */
+test.oneofs.Event.PayloadCase = {
+	PAYLOAD_NOT_SET: 0,
+	TEXT: 2,
+	ATTACHMENT: 3,
+	SIZE: 4
+};
+
+/**
+ * @return {test.oneofs.Event.PayloadCase} The case of the payload oneof.
+ */
+test.oneofs.Event.prototype.getPayloadCase = function() {
+	if (this.jsonData_["text"] != null) {
+		return test.oneofs.Event.PayloadCase.TEXT;
+	}
+	if (this.jsonData_["attachment"] != null) {
+		return test.oneofs.Event.PayloadCase.ATTACHMENT;
+	}
+	if (this.jsonData_["size"] != null) {
+		return test.oneofs.Event.PayloadCase.SIZE;
+	}
+	return test.oneofs.Event.PayloadCase.PAYLOAD_NOT_SET;
+};
+
+/**
+ * Clears all members of the payload oneof.
+ */
+test.oneofs.Event.prototype.clearPayload = function() {
+	delete this.jsonData_["text"];
+	delete this.jsonData_["attachment"];
+	this.attachment_ = undefined;
+	delete this.jsonData_["size"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.oneofs.Event.prototype.equals = function(other) {
+	if (!(other instanceof test.oneofs.Event)) {
+		return false;
+	}
+	if (this.getId() !== other.getId()) {
+		return false;
+	}
+	if (this.getText() !== other.getText()) {
+		return false;
+	}
+	if (!test.oneofs.messageEquals_(this.getAttachment(), other.getAttachment())) {
+		return false;
+	}
+	if (this.getSize() !== other.getSize()) {
+		return false;
+	}
+	if (this.getNote() !== other.getNote()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.oneofs.Event.prototype.deepCopy = function() {
+	return test.oneofs.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.oneofs.Event} A copy of the message, sharing no data with it.
+ */
+test.oneofs.Event.prototype.clone = function() {
+	return new test.oneofs.Event(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.oneofs.Event} other The other message.
+ */
+test.oneofs.Event.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["id"];
+	if (other.hasId()) {
+		this.jsonData_["id"] = v;
+	}
+	v = other.jsonData_["text"];
+	if (other.hasText()) {
+		this.clearPayload();
+		this.jsonData_["text"] = v;
+	}
+	v = other.jsonData_["attachment"];
+	if (other.hasAttachment()) {
+		if (this.hasAttachment()) {
+			this.getAttachment().mergeFrom(other.getAttachment());
+		} else {
+			this.clearPayload();
+			this.jsonData_["attachment"] = test.oneofs.copyJSON_(v);
+		}
+		this.attachment_ = undefined;
+	}
+	v = other.jsonData_["size"];
+	if (other.hasSize()) {
+		this.clearPayload();
+		this.jsonData_["size"] = v;
+	}
+	v = other.jsonData_["note"];
+	if (other.hasNote()) {
+		this.jsonData_["note"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.oneofs.Event.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.oneofs.fieldMaskPaths_(paths, "id");
+	if (p !== true) {
+		delete this.jsonData_["id"];
+	}
+	p = test.oneofs.fieldMaskPaths_(paths, "text");
+	if (p !== true) {
+		delete this.jsonData_["text"];
+	}
+	p = test.oneofs.fieldMaskPaths_(paths, "attachment");
+	if (p !== true) {
+		if (p.length && this.hasAttachment()) {
+			this.getAttachment().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["attachment"];
+			this.attachment_ = undefined;
+		}
+	}
+	p = test.oneofs.fieldMaskPaths_(paths, "size");
+	if (p !== true) {
+		delete this.jsonData_["size"];
+	}
+	p = test.oneofs.fieldMaskPaths_(paths, "note");
+	if (p !== true) {
+		delete this.jsonData_["note"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.oneofs.Event} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.oneofs.Event.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getId() !== other.getId()) {
+		paths.push("id");
+	}
+	if (this.hasText() !== other.hasText() || this.getText() !== other.getText()) {
+		paths.push("text");
+	}
+	if (this.hasAttachment() && other.hasAttachment()) {
+		test.oneofs.appendPaths_(paths, "attachment.", this.getAttachment().diffFieldMask(other.getAttachment()));
+	} else if (this.hasAttachment() !== other.hasAttachment()) {
+		paths.push("attachment");
+	}
+	if (this.hasSize() !== other.hasSize() || this.getSize() !== other.getSize()) {
+		paths.push("size");
+	}
+	if (this.hasNote() !== other.hasNote() || this.getNote() !== other.getNote()) {
+		paths.push("note");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.oneofs.Event.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasAttachment()) {
+		v = this.getAttachment();
+		test.oneofs.nestedViolations_(violations, "attachment", v.validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.oneofs.Event.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.oneofs.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.oneofs.verifyValue_(errors, json["id"], prefix + "id", test.oneofs.verifyString_);
+	test.oneofs.verifyValue_(errors, json["text"], prefix + "text", test.oneofs.verifyString_);
+	test.oneofs.verifyValue_(errors, json["attachment"], prefix + "attachment", test.oneofs.Attachment.verify);
+	test.oneofs.verifyValue_(errors, json["size"], prefix + "size", test.oneofs.verifyInteger_(64, false, false));
+	test.oneofs.verifyValue_(errors, json["note"], prefix + "note", test.oneofs.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.oneofs.Event.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.oneofs.Event.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.oneofs.Event} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.oneofs.Event.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["id"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+	v = message.jsonData_["text"];
+	if (v != null) {
+		writer.writeString(2, v);
+	}
+	v = message.jsonData_["attachment"];
+	if (v != null) {
+		writer.writeMessage(3, new test.oneofs.Attachment(v), test.oneofs.Attachment.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["size"];
+	if (v != null) {
+		writer.writeInt64(4, v);
+	}
+	v = message.jsonData_["note"];
+	if (v != null) {
+		writer.writeString(5, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.oneofs.Event} The message.
+ */
+test.oneofs.Event.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.oneofs.Event.deserializeBinaryFromReader(new test.oneofs.Event({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.oneofs.Event} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.oneofs.Event} The message.
+ */
+test.oneofs.Event.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["id"] = value;
+			break;
+		case 2:
+			delete message.jsonData_["attachment"];
+			message.attachment_ = undefined;
+			delete message.jsonData_["size"];
+			value = reader.readString();
+			message.jsonData_["text"] = value;
+			break;
+		case 3:
+			delete message.jsonData_["text"];
+			delete message.jsonData_["size"];
+			value = new test.oneofs.Attachment({});
+			reader.readMessage(value, test.oneofs.Attachment.deserializeBinaryFromReader);
+			message.jsonData_["attachment"] = value.getJsonData();
+			message.attachment_ = undefined;
+			break;
+		case 4:
+			delete message.jsonData_["text"];
+			delete message.jsonData_["attachment"];
+			message.attachment_ = undefined;
+			value = reader.readInt64();
+			message.jsonData_["size"] = value;
+			break;
+		case 5:
+			value = reader.readString();
+			message.jsonData_["note"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.oneofs.Attachment = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.oneofs.Attachment.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.oneofs.Attachment.TYPE_NAME = 'test.oneofs.Attachment';
+
+/**
+ * @return {string}
+ */
+test.oneofs.Attachment.prototype.getUrl = function() {
+	var v = this.jsonData_["url"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} url The url.
+ */
+test.oneofs.Attachment.prototype.setUrl = function(url) {
+	this.jsonData_["url"] = url;
+};
+
+/**
+ * @return {boolean} Whether the url is set to a value other than the default.
+ */
+test.oneofs.Attachment.prototype.hasUrl = function() {
+	return this.getUrl() !== '';
+};
+
+/**
+ * Clears the url.
+ */
+test.oneofs.Attachment.prototype.clearUrl = function() {
+	delete this.jsonData_["url"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.oneofs.Attachment.prototype.equals = function(other) {
+	if (!(other instanceof test.oneofs.Attachment)) {
+		return false;
+	}
+	if (this.getUrl() !== other.getUrl()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.oneofs.Attachment.prototype.deepCopy = function() {
+	return test.oneofs.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.oneofs.Attachment} A copy of the message, sharing no data with it.
+ */
+test.oneofs.Attachment.prototype.clone = function() {
+	return new test.oneofs.Attachment(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.oneofs.Attachment} other The other message.
+ */
+test.oneofs.Attachment.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["url"];
+	if (other.hasUrl()) {
+		this.jsonData_["url"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.oneofs.Attachment.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.oneofs.fieldMaskPaths_(paths, "url");
+	if (p !== true) {
+		delete this.jsonData_["url"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.oneofs.Attachment} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.oneofs.Attachment.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getUrl() !== other.getUrl()) {
+		paths.push("url");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.oneofs.Attachment.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.oneofs.Attachment.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.oneofs.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.oneofs.verifyValue_(errors, json["url"], prefix + "url", test.oneofs.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.oneofs.Attachment.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.oneofs.Attachment.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.oneofs.Attachment} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.oneofs.Attachment.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["url"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.oneofs.Attachment} The message.
+ */
+test.oneofs.Attachment.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.oneofs.Attachment.deserializeBinaryFromReader(new test.oneofs.Attachment({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.oneofs.Attachment} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.oneofs.Attachment} The message.
+ */
+test.oneofs.Attachment.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["url"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.oneofs.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.oneofs.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.oneofs.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.oneofs.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.oneofs.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.oneofs.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.oneofs.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.oneofs.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.oneofs.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.oneofs.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.oneofs.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.oneofs.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.oneofs.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.oneofs.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.oneofs.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.oneofs.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
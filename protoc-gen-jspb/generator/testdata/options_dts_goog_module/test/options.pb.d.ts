@@ -0,0 +1,29 @@
+// Code generated by protoc-gen-js.
+// source: test/options.proto
+// DO NOT EDIT!
+
+declare module 'goog:test.opt.options' {
+
+	export class Limits {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getSize(): number;
+		setSize(size: number): void;
+		hasSize(): boolean;
+		clearSize(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): Limits;
+		mergeFrom(other: Limits): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: Limits): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): Limits;
+		static maxSize: {readonly fieldNumber: number; readonly fullName: string; readonly extendee: string; fromJSON(v: any): number; toJSON(v: number): any; write(v: any, writer: any): void; read(reader: any, v: any): any};
+	}
+
+	export const label: {readonly fieldNumber: number; readonly fullName: string; readonly extendee: string; fromJSON(v: any): string; toJSON(v: string): any; write(v: any, writer: any): void; read(reader: any, v: any): any};
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-js.
+// source: test/options.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.module('test.opt.options');
+
+const jspbBinaryReader = goog.require('jspb.BinaryReader');
+const jspbBinaryWriter = goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+const Limits = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+Limits.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+Limits.TYPE_NAME = 'test.opt.Limits';
+
+/**
+ * @return {number}
+ */
+Limits.prototype.getSize = function() {
+	var v = this.jsonData_["size"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} size The size.
+ */
+Limits.prototype.setSize = function(size) {
+	this.jsonData_["size"] = size;
+};
+
+/**
+ * @return {boolean} Whether the size is set.
+ */
+Limits.prototype.hasSize = function() {
+	return this.jsonData_["size"] != null;
+};
+
+/**
+ * Clears the size.
+ */
+Limits.prototype.clearSize = function() {
+	delete this.jsonData_["size"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+Limits.prototype.equals = function(other) {
+	if (!(other instanceof Limits)) {
+		return false;
+	}
+	if (this.getSize() !== other.getSize()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+Limits.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!Limits} A copy of the message, sharing no data with it.
+ */
+Limits.prototype.clone = function() {
+	return new Limits(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!Limits} other The other message.
+ */
+Limits.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["size"];
+	if (other.hasSize()) {
+		this.jsonData_["size"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+Limits.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "size");
+	if (p !== true) {
+		delete this.jsonData_["size"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!Limits} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+Limits.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasSize() !== other.hasSize() || this.getSize() !== other.getSize()) {
+		paths.push("size");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+Limits.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+Limits.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["size"], prefix + "size", verifyInteger(32, false, false));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+Limits.prototype.serializeBinary = function() {
+	var writer = new jspbBinaryWriter();
+	Limits.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!Limits} message The message.
+ * @param {!jspbBinaryWriter} writer The writer.
+ */
+Limits.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["size"];
+	if (v != null) {
+		writer.writeInt32(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!Limits} The message.
+ */
+Limits.deserializeBinary = function(bytes) {
+	var reader = new jspbBinaryReader(bytes);
+	return Limits.deserializeBinaryFromReader(new Limits({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!Limits} message The message.
+ * @param {!jspbBinaryReader} reader The reader.
+ * @return {!Limits} The message.
+ */
+Limits.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readInt32();
+			message.jsonData_["size"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * The label extension of google.protobuf.FieldOptions.
+ * @const
+ */
+const label = {
+	fieldNumber: 50001,
+	fullName: 'test.opt.label',
+	extendee: 'google.protobuf.FieldOptions',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {string} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? v : '';
+	},
+	/**
+	 * @param {string} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspbBinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeString(50001, v);
+	},
+	/**
+	 * @param {!jspbBinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readString();
+		v = value;
+		return v;
+	}
+};
+
+/**
+ * The max_size extension of google.protobuf.MessageOptions.
+ * @const
+ */
+Limits.maxSize = {
+	fieldNumber: 50002,
+	fullName: 'test.opt.Limits.max_size',
+	extendee: 'google.protobuf.MessageOptions',
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @return {number} The value, or the default if unset.
+	 */
+	fromJSON: function(v) {
+		return v != null ? v : 0;
+	},
+	/**
+	 * @param {number} v The value.
+	 * @return {*} The JSON data of the value.
+	 */
+	toJSON: function(v) {
+		return v;
+	},
+	/**
+	 * @param {*} v The JSON data of the value.
+	 * @param {!jspbBinaryWriter} writer The writer.
+	 */
+	write: function(v, writer) {
+		writer.writeInt32(50002, v);
+	},
+	/**
+	 * @param {!jspbBinaryReader} reader The reader.
+	 * @param {*} v The JSON data of the previous value.
+	 * @return {*} The JSON data of the value.
+	 */
+	read: function(reader, v) {
+		var value;
+		value = reader.readInt32();
+		v = value;
+		return v;
+	}
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+function copyJSON(v) {
+	if (Array.isArray(v)) {
+		return v.map(copyJSON);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = copyJSON(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+}
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+function fieldMaskPaths(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+}
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyDecimal(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[verifyError(path, expected, v)];
+	};
+}
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+function verifyError(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+}
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+function verifyInteger(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return verifyDecimal(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[verifyError(path, expected, v)];
+	};
+}
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+function verifyValue(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+}
+
+exports = {
+	Limits,
+	label
+};
@@ -0,0 +1,372 @@
+// Code generated by protoc-gen-js.
+// source: test/public.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.pub.Wrapper');
+
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+goog.require('test.dep.Dep');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.pub.Wrapper = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.pub.Wrapper.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.pub.Wrapper.TYPE_NAME = 'test.pub.Wrapper';
+
+/**
+ * @return {test.dep.Dep}
+ */
+test.pub.Wrapper.prototype.getDep = function() {
+	if (this.dep_) {
+		return this.dep_;
+	}
+	var v = this.jsonData_["dep"];
+	if (v) {
+		/** @private {test.dep.Dep} */
+		this.dep_ = new test.dep.Dep(v);
+		return this.dep_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.dep.Dep} dep The dep.
+ */
+test.pub.Wrapper.prototype.setDep = function(dep) {
+	this.jsonData_["dep"] = dep.getJsonData();
+	this.dep_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the dep is set.
+ */
+test.pub.Wrapper.prototype.hasDep = function() {
+	return this.jsonData_["dep"] != null;
+};
+
+/**
+ * Clears the dep.
+ */
+test.pub.Wrapper.prototype.clearDep = function() {
+	delete this.jsonData_["dep"];
+	this.dep_ = undefined;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.pub.Wrapper.prototype.equals = function(other) {
+	if (!(other instanceof test.pub.Wrapper)) {
+		return false;
+	}
+	if (!test.pub.messageEquals_(this.getDep(), other.getDep())) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.pub.Wrapper.prototype.deepCopy = function() {
+	return test.pub.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.pub.Wrapper} A copy of the message, sharing no data with it.
+ */
+test.pub.Wrapper.prototype.clone = function() {
+	return new test.pub.Wrapper(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.pub.Wrapper} other The other message.
+ */
+test.pub.Wrapper.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["dep"];
+	if (other.hasDep()) {
+		if (this.hasDep()) {
+			this.getDep().mergeFrom(other.getDep());
+		} else {
+			this.jsonData_["dep"] = test.pub.copyJSON_(v);
+		}
+		this.dep_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.pub.Wrapper.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.pub.fieldMaskPaths_(paths, "dep");
+	if (p !== true) {
+		if (p.length && this.hasDep()) {
+			this.getDep().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["dep"];
+			this.dep_ = undefined;
+		}
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.pub.Wrapper} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.pub.Wrapper.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasDep() && other.hasDep()) {
+		test.pub.appendPaths_(paths, "dep.", this.getDep().diffFieldMask(other.getDep()));
+	} else if (this.hasDep() !== other.hasDep()) {
+		paths.push("dep");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.pub.Wrapper.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasDep()) {
+		v = this.getDep();
+		test.pub.nestedViolations_(violations, "dep", v.validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.pub.Wrapper.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.pub.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.pub.verifyValue_(errors, json["dep"], prefix + "dep", test.dep.Dep.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.pub.Wrapper.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.pub.Wrapper.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.pub.Wrapper} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.pub.Wrapper.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["dep"];
+	if (v != null) {
+		writer.writeMessage(1, new test.dep.Dep(v), test.dep.Dep.serializeBinaryToWriter);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.pub.Wrapper} The message.
+ */
+test.pub.Wrapper.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.pub.Wrapper.deserializeBinaryFromReader(new test.pub.Wrapper({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.pub.Wrapper} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.pub.Wrapper} The message.
+ */
+test.pub.Wrapper.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new test.dep.Dep({});
+			reader.readMessage(value, test.dep.Dep.deserializeBinaryFromReader);
+			message.jsonData_["dep"] = value.getJsonData();
+			message.dep_ = undefined;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.pub.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.pub.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.pub.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.pub.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.pub.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.pub.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.pub.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.pub.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.pub.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
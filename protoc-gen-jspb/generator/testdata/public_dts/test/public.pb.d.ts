@@ -0,0 +1,25 @@
+// Code generated by protoc-gen-js.
+// source: test/public.proto
+// DO NOT EDIT!
+
+declare namespace test.pub {
+	class Wrapper {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getDep(): test.dep.Dep;
+		setDep(dep: test.dep.Dep): void;
+		hasDep(): boolean;
+		clearDep(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): test.pub.Wrapper;
+		mergeFrom(other: test.pub.Wrapper): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: test.pub.Wrapper): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): test.pub.Wrapper;
+	}
+}
@@ -0,0 +1,29 @@
+// Code generated by protoc-gen-js.
+// source: test/public.proto
+// DO NOT EDIT!
+
+declare module 'goog:test.pub.public' {
+	import * as test_dep_pb from 'goog:test.dep.dep';
+	export import Dep = test_dep_pb.Dep;
+	export import Level = test_dep_pb.Level;
+
+	export class Wrapper {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getDep(): test_dep_pb.Dep;
+		setDep(dep: test_dep_pb.Dep): void;
+		hasDep(): boolean;
+		clearDep(): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): Wrapper;
+		mergeFrom(other: Wrapper): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: Wrapper): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): Wrapper;
+	}
+}
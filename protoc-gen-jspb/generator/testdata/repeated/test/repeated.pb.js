@@ -0,0 +1,824 @@
+// Code generated by protoc-gen-js.
+// source: test/repeated.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.repeated.Lists');
+goog.provide('test.repeated.Item');
+
+goog.require('goog.array');
+goog.require('goog.crypt.base64');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.repeated.Lists = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.repeated.Lists.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.repeated.Lists.TYPE_NAME = 'test.repeated.Lists';
+
+/**
+ * @return {Array.<number>}
+ */
+test.repeated.Lists.prototype.getIds = function() {
+	return this.jsonData_["ids"] || [];
+};
+
+/**
+ * @param {Array.<number>} ids The ids.
+ */
+test.repeated.Lists.prototype.setIds = function(ids) {
+	this.jsonData_["ids"] = ids;
+};
+
+/**
+ * @return {Array.<string>}
+ */
+test.repeated.Lists.prototype.getNames = function() {
+	return this.jsonData_["names"] || [];
+};
+
+/**
+ * @param {Array.<string>} names The names.
+ */
+test.repeated.Lists.prototype.setNames = function(names) {
+	this.jsonData_["names"] = names;
+};
+
+/**
+ * @return {Array.<number>}
+ */
+test.repeated.Lists.prototype.getScores = function() {
+	return this.jsonData_["scores"] || [];
+};
+
+/**
+ * @param {Array.<number>} scores The scores.
+ */
+test.repeated.Lists.prototype.setScores = function(scores) {
+	this.jsonData_["scores"] = scores;
+};
+
+/**
+ * @return {Array.<string>}
+ */
+test.repeated.Lists.prototype.getBlobs = function() {
+	return this.jsonData_["blobs"] || [];
+};
+
+/**
+ * @param {Array.<string>} blobs The blobs.
+ */
+test.repeated.Lists.prototype.setBlobs = function(blobs) {
+	this.jsonData_["blobs"] = blobs;
+};
+
+/**
+ * @return {Array.<test.repeated.Item>}
+ */
+test.repeated.Lists.prototype.getItems = function() {
+	if (this.items_) {
+		return this.items_;
+	}
+	var v = this.jsonData_["items"];
+	if (v) {
+		/** @private {Array.<test.repeated.Item>} */
+		this.items_ = [];
+		goog.array.forEach(v, function(__item, __index) {
+			this.items_.push(new test.repeated.Item(__item));
+		}, this);
+		return this.items_;
+	}
+	return [];
+};
+
+/**
+ * @param {Array.<test.repeated.Item>} items The items.
+ */
+test.repeated.Lists.prototype.setItems = function(items) {
+	this.jsonData_["items"] = (items || []).map(function(__item) {
+		return __item.getJsonData();
+	});
+	this.items_ = undefined;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.repeated.Lists.prototype.equals = function(other) {
+	if (!(other instanceof test.repeated.Lists)) {
+		return false;
+	}
+	if (!test.repeated.arrayEquals_(this.getIds(), other.getIds(), null)) {
+		return false;
+	}
+	if (!test.repeated.arrayEquals_(this.getNames(), other.getNames(), null)) {
+		return false;
+	}
+	if (!test.repeated.arrayEquals_(this.getScores(), other.getScores(), test.repeated.floatEquals_)) {
+		return false;
+	}
+	if (!test.repeated.arrayEquals_(this.getBlobs(), other.getBlobs(), null)) {
+		return false;
+	}
+	if (!test.repeated.arrayEquals_(this.getItems(), other.getItems(), test.repeated.messageEquals_)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.repeated.Lists.prototype.deepCopy = function() {
+	return test.repeated.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.repeated.Lists} A copy of the message, sharing no data with it.
+ */
+test.repeated.Lists.prototype.clone = function() {
+	return new test.repeated.Lists(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.repeated.Lists} other The other message.
+ */
+test.repeated.Lists.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["ids"];
+	if (v != null && v.length) {
+		this.jsonData_["ids"] = (this.jsonData_["ids"] || []).concat(v);
+	}
+	v = other.jsonData_["names"];
+	if (v != null && v.length) {
+		this.jsonData_["names"] = (this.jsonData_["names"] || []).concat(v);
+	}
+	v = other.jsonData_["scores"];
+	if (v != null && v.length) {
+		this.jsonData_["scores"] = (this.jsonData_["scores"] || []).concat(v);
+	}
+	v = other.jsonData_["blobs"];
+	if (v != null && v.length) {
+		this.jsonData_["blobs"] = (this.jsonData_["blobs"] || []).concat(v);
+	}
+	v = other.jsonData_["items"];
+	if (v != null && v.length) {
+		this.jsonData_["items"] = (this.jsonData_["items"] || []).concat(test.repeated.copyJSON_(v));
+		this.items_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.repeated.Lists.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.repeated.fieldMaskPaths_(paths, "ids");
+	if (p !== true) {
+		delete this.jsonData_["ids"];
+	}
+	p = test.repeated.fieldMaskPaths_(paths, "names");
+	if (p !== true) {
+		delete this.jsonData_["names"];
+	}
+	p = test.repeated.fieldMaskPaths_(paths, "scores");
+	if (p !== true) {
+		delete this.jsonData_["scores"];
+	}
+	p = test.repeated.fieldMaskPaths_(paths, "blobs");
+	if (p !== true) {
+		delete this.jsonData_["blobs"];
+	}
+	p = test.repeated.fieldMaskPaths_(paths, "items");
+	if (p !== true) {
+		delete this.jsonData_["items"];
+		this.items_ = undefined;
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.repeated.Lists} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.repeated.Lists.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (!test.repeated.arrayEquals_(this.getIds(), other.getIds(), null)) {
+		paths.push("ids");
+	}
+	if (!test.repeated.arrayEquals_(this.getNames(), other.getNames(), null)) {
+		paths.push("names");
+	}
+	if (!test.repeated.arrayEquals_(this.getScores(), other.getScores(), test.repeated.floatEquals_)) {
+		paths.push("scores");
+	}
+	if (!test.repeated.arrayEquals_(this.getBlobs(), other.getBlobs(), null)) {
+		paths.push("blobs");
+	}
+	if (!test.repeated.arrayEquals_(this.getItems(), other.getItems(), test.repeated.messageEquals_)) {
+		paths.push("items");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.repeated.Lists.prototype.validate = function() {
+	var violations = [];
+	var v;
+	v = this.getItems();
+	goog.array.forEach(v, function(__item, __index) {
+		test.repeated.nestedViolations_(violations, "items[" + __index + "]", __item.validate());
+	}, this);
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.repeated.Lists.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.repeated.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.repeated.verifyArray_(errors, json["ids"], prefix + "ids", test.repeated.verifyInteger_(32, false, false));
+	test.repeated.verifyArray_(errors, json["names"], prefix + "names", test.repeated.verifyString_);
+	test.repeated.verifyArray_(errors, json["scores"], prefix + "scores", test.repeated.verifyNumber_);
+	test.repeated.verifyArray_(errors, json["blobs"], prefix + "blobs", test.repeated.verifyString_);
+	test.repeated.verifyArray_(errors, json["items"], prefix + "items", test.repeated.Item.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.repeated.Lists.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.repeated.Lists.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.repeated.Lists} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.repeated.Lists.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["ids"];
+	if (v != null) {
+		writer.writePackedInt32(1, v);
+	}
+	v = message.jsonData_["names"];
+	if (v != null) {
+		writer.writeRepeatedString(2, v);
+	}
+	v = message.jsonData_["scores"];
+	if (v != null) {
+		writer.writePackedDouble(3, v);
+	}
+	v = message.jsonData_["blobs"];
+	if (v != null) {
+		writer.writeRepeatedBytes(4, v);
+	}
+	v = message.jsonData_["items"];
+	if (v != null) {
+		goog.array.forEach(v, function(__item) {
+			writer.writeMessage(5, new test.repeated.Item(__item), test.repeated.Item.serializeBinaryToWriter);
+		}, this);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.repeated.Lists} The message.
+ */
+test.repeated.Lists.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.repeated.Lists.deserializeBinaryFromReader(new test.repeated.Lists({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.repeated.Lists} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.repeated.Lists} The message.
+ */
+test.repeated.Lists.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.isDelimited() ? reader.readPackedInt32() : [reader.readInt32()];
+			message.jsonData_["ids"] = (message.jsonData_["ids"] || []).concat(value);
+			break;
+		case 2:
+			value = [reader.readString()];
+			message.jsonData_["names"] = (message.jsonData_["names"] || []).concat(value);
+			break;
+		case 3:
+			value = reader.isDelimited() ? reader.readPackedDouble() : [reader.readDouble()];
+			message.jsonData_["scores"] = (message.jsonData_["scores"] || []).concat(value);
+			break;
+		case 4:
+			value = [goog.crypt.base64.encodeByteArray(reader.readBytes())];
+			message.jsonData_["blobs"] = (message.jsonData_["blobs"] || []).concat(value);
+			break;
+		case 5:
+			value = new test.repeated.Item({});
+			reader.readMessage(value, test.repeated.Item.deserializeBinaryFromReader);
+			message.jsonData_["items"] = message.jsonData_["items"] || [];
+			message.jsonData_["items"].push(value.getJsonData());
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.repeated.Item = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.repeated.Item.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.repeated.Item.TYPE_NAME = 'test.repeated.Item';
+
+/**
+ * @return {string}
+ */
+test.repeated.Item.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+test.repeated.Item.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set to a value other than the default.
+ */
+test.repeated.Item.prototype.hasName = function() {
+	return this.getName() !== '';
+};
+
+/**
+ * Clears the name.
+ */
+test.repeated.Item.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.repeated.Item.prototype.equals = function(other) {
+	if (!(other instanceof test.repeated.Item)) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.repeated.Item.prototype.deepCopy = function() {
+	return test.repeated.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.repeated.Item} A copy of the message, sharing no data with it.
+ */
+test.repeated.Item.prototype.clone = function() {
+	return new test.repeated.Item(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.repeated.Item} other The other message.
+ */
+test.repeated.Item.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.repeated.Item.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.repeated.fieldMaskPaths_(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.repeated.Item} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.repeated.Item.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.repeated.Item.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.repeated.Item.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.repeated.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.repeated.verifyValue_(errors, json["name"], prefix + "name", test.repeated.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.repeated.Item.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.repeated.Item.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.repeated.Item} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.repeated.Item.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.repeated.Item} The message.
+ */
+test.repeated.Item.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.repeated.Item.deserializeBinaryFromReader(new test.repeated.Item({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.repeated.Item} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.repeated.Item} The message.
+ */
+test.repeated.Item.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.repeated.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.repeated.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.repeated.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.repeated.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.repeated.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two floating point numbers, NaN being equal to NaN.
+ * @param {?number} a The first number.
+ * @param {?number} b The second number.
+ * @return {boolean} Whether the numbers are equal.
+ */
+test.repeated.floatEquals_ = function(a, b) {
+	return a === b || (a !== a && b !== b);
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.repeated.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.repeated.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.repeated.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.repeated.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.repeated.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.repeated.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.repeated.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.repeated.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.repeated.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.repeated.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks that a JSON value is a number.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.repeated.verifyNumber_ = function(v, path) {
+	return typeof v === 'number' ? [] : [test.repeated.verifyError_(path, 'a number', v)];
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.repeated.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.repeated.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.repeated.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
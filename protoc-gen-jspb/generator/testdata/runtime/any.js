@@ -0,0 +1,24 @@
+// The registry of the classes packed in google.protobuf.Any is shared by the
+// generated modules.
+import assert from 'node:assert/strict';
+import {Record} from './test/wkt.pb.js';
+import {Envelope, registerAnyTypes} from './test/packed.pb.js';
+
+// Nothing is registered implicitly.
+const record = new Record({});
+record.packPayload(new Envelope({content: {'@type': 'type.googleapis.com/x.Y'}}));
+assert.equal(record.unpackPayload(), null);
+
+// A class registered by one module is unpacked by the others.
+registerAnyTypes(Envelope);
+const envelope = record.unpackPayload();
+assert.ok(envelope instanceof Envelope);
+assert.equal(envelope.getContent()['@type'], 'type.googleapis.com/x.Y');
+
+// And the other way around.
+const outer = new Envelope({});
+outer.packContent(new Record({timeout: '5s'}));
+assert.equal(outer.unpackContent(), null);
+registerAnyTypes(Record);
+assert.ok(outer.unpackContent() instanceof Record);
+assert.equal(outer.unpackContent().getJsonData()['timeout'], '5s');
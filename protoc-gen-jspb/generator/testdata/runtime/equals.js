@@ -0,0 +1,42 @@
+// equals, clone and deepCopy.
+import assert from 'node:assert/strict';
+import {Sample} from './test/equals.pb.js';
+
+const equal = (a, b) => new Sample(a).equals(new Sample(b));
+
+// Defaults equal absence, with or without presence.
+assert.ok(equal({}, {ratio: 0, id: 0, samples: [], totals: {}}));
+assert.ok(equal({}, {limit: 0}));
+assert.ok(equal({}, {text: ''}));
+assert.ok(equal({number: 0}, {text: ''}));
+assert.ok(!equal({limit: 0}, {limit: 1}));
+assert.ok(!equal({text: 'a'}, {number: 1}));
+
+// NaN equals NaN, in singular and repeated fields.
+assert.ok(equal({ratio: NaN}, {ratio: NaN}));
+assert.ok(equal({samples: [1, NaN]}, {samples: [1, NaN]}));
+assert.ok(!equal({samples: [1, NaN]}, {samples: [1]}));
+
+// Maps are compared by key.
+assert.ok(equal({totals: {a: 1, b: 2}}, {totals: {b: 2, a: 1}}));
+assert.ok(!equal({totals: {a: 1}}, {totals: {a: 1, b: 2}}));
+
+// Messages are compared recursively, an unset message differing from an
+// empty one.
+assert.ok(equal({child: {child: {id: 3}}}, {child: {child: {id: 3}}}));
+assert.ok(!equal({child: {child: {id: 3}}}, {child: {child: {id: 4}}}));
+assert.ok(!equal({}, {child: {}}));
+
+assert.ok(!new Sample({}).equals(null));
+assert.ok(!new Sample({}).equals({}));
+
+// The copies share no data.
+const sample = new Sample({samples: [1], child: {id: 2}});
+const copy = sample.clone();
+assert.ok(copy.equals(sample));
+copy.getChild().setId(5);
+copy.getSamples().push(2);
+assert.equal(sample.getChild().getId(), 2);
+assert.deepEqual(sample.getSamples(), [1]);
+assert.notEqual(sample.deepCopy(), sample.getJsonData());
+assert.deepEqual(sample.deepCopy(), sample.getJsonData());
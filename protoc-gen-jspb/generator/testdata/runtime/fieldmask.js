@@ -0,0 +1,43 @@
+// applyFieldMask and diffFieldMask.
+import assert from 'node:assert/strict';
+import {Tree} from './test/mask.pb.js';
+
+const masked = (json, paths) => {
+	const tree = new Tree(json);
+	tree.applyFieldMask(paths);
+	return tree.getJsonData();
+};
+
+const tree = {
+	branch: {leaf: {value: 'a'}, label: 'b', leaves: [{value: 'c'}]},
+	other: {label: 'o'},
+	name: 'n',
+	by_name: {x: {value: 'x'}},
+};
+
+// The fields out of the paths are cleared, the paths through messages keeping
+// their fields in the paths.
+assert.deepEqual(masked(structuredClone(tree), ['name']), {name: 'n'});
+assert.deepEqual(masked(structuredClone(tree), ['branch']), {branch: tree.branch});
+assert.deepEqual(masked(structuredClone(tree), ['branch.leaf.value', 'by_name']),
+		{branch: {leaf: {value: 'a'}}, by_name: tree.by_name});
+assert.deepEqual(masked(structuredClone(tree), ['branch.label', 'other.leaf']), {branch: {label: 'b'}, other: {}});
+assert.deepEqual(masked(structuredClone(tree), []), {});
+
+// The paths through unset messages leave them unset.
+assert.deepEqual(masked({name: 'n'}, ['branch.leaf.value', 'other.label']), {});
+assert.deepEqual(masked({branch: {label: 'b'}}, ['branch.leaf.value']), {branch: {}});
+
+// The cached wrappers see the masked data.
+const t = new Tree(structuredClone(tree));
+assert.equal(t.getBranch().getLabel(), 'b');
+t.applyFieldMask(['name']);
+assert.equal(t.getBranch(), undefined);
+
+// The differences descend into the messages set in both messages.
+const diff = (a, b) => new Tree(a).diffFieldMask(new Tree(b));
+assert.deepEqual(diff(tree, structuredClone(tree)), []);
+assert.deepEqual(diff({branch: {label: 'a'}}, {branch: {label: 'b'}}), ['branch.label']);
+assert.deepEqual(diff({branch: {leaf: {value: 'a'}}}, {branch: {}}), ['branch.leaf']);
+assert.deepEqual(diff({branch: {}}, {}), ['branch']);
+assert.deepEqual(diff({name: 'a', by_name: {x: {}}}, {name: 'b'}), ['name', 'by_name']);
@@ -0,0 +1,13 @@
+// A stub of the google-protobuf package, for the runtime tests of the code
+// generated with target=esm. The binary format isn't tested, so the classes
+// only need to exist.
+export class BinaryReader {}
+export class BinaryWriter {}
+export const Message = {
+	bytesAsB64: function(bytes) {
+		return typeof bytes === 'string' ? bytes : Buffer.from(bytes).toString('base64');
+	},
+	bytesAsU8: function(bytes) {
+		return typeof bytes === 'string' ? new Uint8Array(Buffer.from(bytes, 'base64')) : bytes;
+	},
+};
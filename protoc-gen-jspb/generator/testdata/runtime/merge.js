@@ -0,0 +1,52 @@
+// mergeFrom.
+import assert from 'node:assert/strict';
+import {Assembly, Part} from './test/merge.pb.js';
+
+const merged = (a, b) => {
+	const m = new Assembly(a);
+	m.mergeFrom(new Assembly(b));
+	return m.getJsonData();
+};
+
+// The set singular fields overwrite, the defaults of proto3 don't.
+assert.deepEqual(merged({label: 'a'}, {label: 'b'}), {label: 'b'});
+assert.deepEqual(merged({label: 'a'}, {label: ''}), {label: 'a'});
+
+// A set oneof member replaces the active one, a message member merging into
+// the same active member.
+assert.deepEqual(merged({text: 'a'}, {count: 2}), {count: 2});
+assert.deepEqual(merged({count: 2}, {part: {name: 'p'}}), {part: {name: 'p'}});
+assert.deepEqual(merged({part: {name: 'p', tags: ['x']}}, {part: {tags: ['y']}}), {part: {name: 'p', tags: ['x', 'y']}});
+assert.deepEqual(merged({part: {name: 'p'}}, {text: 't'}), {text: 't'});
+assert.deepEqual(merged({text: 't'}, {}), {text: 't'});
+const assembly = new Assembly({part: {name: 'p'}});
+assert.equal(assembly.getPart().getName(), 'p');
+assembly.mergeFrom(new Assembly({count: 1}));
+assert.equal(assembly.getSourceCase(), Assembly.SourceCase.COUNT);
+assert.equal(assembly.getPart(), undefined);
+
+// The repeated fields are appended.
+assert.deepEqual(merged({sizes: [1], parts: [{name: 'a'}]}, {sizes: [2, 3], parts: [{name: 'b'}]}),
+		{sizes: [1, 2, 3], parts: [{name: 'a'}, {name: 'b'}]});
+
+// The maps are merged by key.
+assert.deepEqual(merged({counts: {a: 1, b: 2}, by_name: {x: {name: 'x'}}}, {counts: {b: 3, c: 4}, by_name: {y: {name: 'y'}}}),
+		{counts: {a: 1, b: 3, c: 4}, by_name: {x: {name: 'x'}, y: {name: 'y'}}});
+
+// The messages are merged recursively.
+assert.deepEqual(merged({main: {name: 'm', tags: ['a']}}, {main: {tags: ['b']}}), {main: {name: 'm', tags: ['a', 'b']}});
+
+// The merged data is copied, and the cached wrappers are dropped.
+const other = new Assembly({parts: [{name: 'b'}], main: {name: 'm'}});
+const target = new Assembly({parts: [{name: 'a'}]});
+assert.equal(target.getParts().length, 1);
+target.mergeFrom(other);
+assert.deepEqual(target.getParts().map((p) => p.getName()), ['a', 'b']);
+target.getParts()[1].setName('c');
+target.getMain().setName('n');
+assert.equal(other.getParts()[0].getName(), 'b');
+assert.equal(other.getMain().getName(), 'm');
+
+const part = new Part({tags: ['a']});
+part.mergeFrom(new Part({name: 'p', tags: ['b']}));
+assert.ok(part.equals(new Part({name: 'p', tags: ['a', 'b']})));
@@ -0,0 +1,12 @@
+// The extensions of the options of descriptor.proto, which has no generated
+// code, are standalone descriptors.
+import assert from 'node:assert/strict';
+import {Limits, label} from './test/options.pb.js';
+
+assert.equal(label.fieldNumber, 50001);
+assert.equal(label.extendee, 'google.protobuf.FieldOptions');
+assert.equal(label.fromJSON(undefined), '');
+assert.equal(label.fromJSON('Size'), 'Size');
+assert.equal(Limits.maxSize.fieldNumber, 50002);
+assert.equal(Limits.maxSize.fromJSON(7), 7);
+assert.equal(new Limits({size: 3}).getSize(), 3);
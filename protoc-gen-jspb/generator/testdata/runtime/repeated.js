@@ -0,0 +1,22 @@
+// The setters and getters of the repeated fields.
+import assert from 'node:assert/strict';
+import {Lists, Item} from './test/repeated.pb.js';
+
+const lists = new Lists({});
+assert.deepEqual(lists.getItems(), []);
+lists.setItems([new Item({name: 'a'}), new Item({name: 'b'})]);
+assert.deepEqual(lists.getJsonData(), {items: [{name: 'a'}, {name: 'b'}]});
+assert.deepEqual(lists.getItems().map((item) => item.getName()), ['a', 'b']);
+
+// The cached wrappers are dropped by the setter.
+lists.setItems([new Item({name: 'c'})]);
+assert.deepEqual(lists.getItems().map((item) => item.getName()), ['c']);
+lists.setItems([]);
+assert.deepEqual(lists.getItems(), []);
+lists.setItems(null);
+assert.deepEqual(lists.getJsonData(), {items: []});
+
+lists.setIds([1, 2]);
+lists.setNames(['x']);
+assert.deepEqual(lists.getIds(), [1, 2]);
+assert.deepEqual(lists.getNames(), ['x']);
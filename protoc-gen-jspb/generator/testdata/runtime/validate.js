@@ -0,0 +1,29 @@
+// validate.
+import assert from 'node:assert/strict';
+import {Address, User} from './test/validate.pb.js';
+
+const rules = (message) => message.validate().map((v) => v.field + ' ' + v.rule);
+
+const valid = {name: 'ann', age: 30, score: 11, address: {city: 'c', code: 'FR-1'}, tags: ['abc'], id: 1};
+assert.deepEqual(rules(new User(structuredClone(valid))), []);
+
+assert.deepEqual(rules(new User({})), [
+	'name string.min_len', 'name string.pattern', 'score double.gt', 'address required',
+	'tags repeated.min_items', 'id required', 'id int64.gt',
+]);
+assert.deepEqual(rules(new User({...structuredClone(valid), name: 'Ann', age: 150, score: 5, status: 7})),
+		['name string.pattern', 'age int32.lt', 'score double.gt', 'status enum.defined_only']);
+assert.deepEqual(rules(new User({...structuredClone(valid), tags: ['ab', 'abc', 'abcd', 'abc'], nickname: 'nicknamed'})),
+		['tags repeated.max_items', 'tags[0] string.len', 'tags[2] string.len', 'nickname string.max_len']);
+
+// The violations of the messages of the fields have their paths.
+assert.deepEqual(rules(new User({...structuredClone(valid), address: {code: 'FR-1'}, others: [{city: 'c', code: 'FR-1'}, {code: 'x'}]})),
+		['address.city string.min_len', 'others[1].city string.min_len', 'others[1].code string.pattern']);
+
+// The RE2 pattern (?i)^(?P<country>[a-z]{2})-\d+\z, translated.
+const code = (s) => rules(new Address({city: 'c', code: s})).length === 0;
+assert.ok(code('fr-12'));
+assert.ok(code('FR-12'));
+assert.ok(!code('FRA-12'));
+assert.ok(!code('fr-12\n'));
+assert.ok(!code('fr-'));
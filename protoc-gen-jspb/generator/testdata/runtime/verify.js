@@ -0,0 +1,33 @@
+// verify, with the JSON data of the default mapping.
+import assert from 'node:assert/strict';
+import {Item, Order} from './test/verify.pb.js';
+
+assert.deepEqual(Order.verify({item: {count: 1, kind: 2}, kinds: [1], totals: {'1': -2}, serial: 5}), []);
+assert.deepEqual(Order.verify([]), ['expected an object, got array']);
+
+// The proto2 required fields must be set.
+assert.deepEqual(Order.verify({}), ['item: missing required field']);
+assert.deepEqual(Order.verify({item: null}), ['item: missing required field']);
+
+// The integers must be numbers in the range of their types.
+assert.deepEqual(Item.verify({count: -1}), ['count: expected an unsigned 32-bit integer, got number -1']);
+assert.deepEqual(Item.verify({count: 4294967295}), []);
+assert.deepEqual(Item.verify({count: 4294967296}), ['count: expected an unsigned 32-bit integer, got number 4294967296']);
+assert.deepEqual(Item.verify({count: '12'}), ['count: expected an unsigned 32-bit integer, got string "12"']);
+assert.deepEqual(Item.verify({count: 1.5}), ['count: expected an unsigned 32-bit integer, got number 1.5']);
+assert.deepEqual(Order.verify({item: {}, totals: {'1': 2147483647, '2': -2147483648, '3': 2147483648, '4': -2147483649}}), [
+	'totals["3"]: expected a 32-bit integer, got number 2147483648',
+	'totals["4"]: expected a 32-bit integer, got number -2147483649',
+]);
+assert.deepEqual(Order.verify({item: {}, serial: -5}), ['serial: expected an unsigned 64-bit integer, got number -5']);
+
+// The values of the closed enums must be defined, and given by number.
+assert.deepEqual(Item.verify({kind: 3}), ['kind: expected a value of the enum, got number 3']);
+assert.deepEqual(Item.verify({kind: 'BOOK'}), ['kind: expected an enum value number, got string "BOOK"']);
+
+// The errors of the nested messages have their paths.
+assert.deepEqual(Order.verify({item: {count: 'x'}, items: [{}, {kind: 0}], by_name: {a: []}}), [
+	'item.count: expected an unsigned 32-bit integer, got string "x"',
+	'items[1].kind: expected a value of the enum, got number 0',
+	'by_name["a"]: expected an object, got array',
+]);
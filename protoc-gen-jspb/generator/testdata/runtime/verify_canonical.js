@@ -0,0 +1,25 @@
+// verify, with the JSON data of the canonical mapping.
+import assert from 'node:assert/strict';
+import {Item, Order} from './test/verify.pb.js';
+
+assert.deepEqual(Order.verify({item: {count: 1, kind: 'DISC'}, kinds: ['BOOK', 2], totals: {'1': '-2'}, serial: '5'}), []);
+
+// The proto2 required fields must be set.
+assert.deepEqual(Order.verify({byName: {}}), ['item: missing required field']);
+
+// The integers may be quoted, and must be in the range of their types.
+assert.deepEqual(Item.verify({count: '12'}), []);
+assert.deepEqual(Item.verify({count: '-1'}), ['count: expected an unsigned 32-bit integer, got string "-1"']);
+assert.deepEqual(Item.verify({count: '4294967296'}), ['count: expected an unsigned 32-bit integer, got string "4294967296"']);
+assert.deepEqual(Item.verify({count: '1.5'}), ['count: expected an unsigned 32-bit integer, got string "1.5"']);
+assert.deepEqual(Order.verify({item: {}, totals: {'1': '2147483648'}}), ['totals["1"]: expected a 32-bit integer, got string "2147483648"']);
+assert.deepEqual(Order.verify({item: {}, serial: '18446744073709551615'}), []);
+assert.deepEqual(Order.verify({item: {}, serial: '18446744073709551616'}),
+		['serial: expected the decimal string of an unsigned 64-bit integer, got string "18446744073709551616"']);
+assert.deepEqual(Order.verify({item: {}, serial: '-1'}),
+		['serial: expected the decimal string of an unsigned 64-bit integer, got string "-1"']);
+
+// The enum values may be names or numbers, quoted or not.
+assert.deepEqual(Item.verify({kind: '2'}), []);
+assert.deepEqual(Item.verify({kind: 'TAPE'}), ['kind: expected a name of a value of the enum, got string "TAPE"']);
+assert.deepEqual(Item.verify({kind: 3}), ['kind: expected a value of the enum, got number 3']);
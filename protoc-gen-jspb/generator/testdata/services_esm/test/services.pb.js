@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-js.
+// source: test/services.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+import * as jspb from 'google-protobuf';
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const HelloRequest = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+HelloRequest.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+HelloRequest.TYPE_NAME = 'test.svc.HelloRequest';
+
+/**
+ * @return {string}
+ */
+HelloRequest.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+HelloRequest.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set to a value other than the default.
+ */
+HelloRequest.prototype.hasName = function() {
+	return this.getName() !== '';
+};
+
+/**
+ * Clears the name.
+ */
+HelloRequest.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+HelloRequest.prototype.equals = function(other) {
+	if (!(other instanceof HelloRequest)) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+HelloRequest.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!HelloRequest} A copy of the message, sharing no data with it.
+ */
+HelloRequest.prototype.clone = function() {
+	return new HelloRequest(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!HelloRequest} other The other message.
+ */
+HelloRequest.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+HelloRequest.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!HelloRequest} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+HelloRequest.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+HelloRequest.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+HelloRequest.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["name"], prefix + "name", verifyString);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+HelloRequest.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	HelloRequest.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!HelloRequest} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+HelloRequest.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!HelloRequest} The message.
+ */
+HelloRequest.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return HelloRequest.deserializeBinaryFromReader(new HelloRequest({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!HelloRequest} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!HelloRequest} The message.
+ */
+HelloRequest.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+export const HelloReply = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+HelloReply.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+HelloReply.TYPE_NAME = 'test.svc.HelloReply';
+
+/**
+ * @return {string}
+ */
+HelloReply.prototype.getMessage = function() {
+	var v = this.jsonData_["message"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} message The message.
+ */
+HelloReply.prototype.setMessage = function(message) {
+	this.jsonData_["message"] = message;
+};
+
+/**
+ * @return {boolean} Whether the message is set to a value other than the default.
+ */
+HelloReply.prototype.hasMessage = function() {
+	return this.getMessage() !== '';
+};
+
+/**
+ * Clears the message.
+ */
+HelloReply.prototype.clearMessage = function() {
+	delete this.jsonData_["message"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+HelloReply.prototype.equals = function(other) {
+	if (!(other instanceof HelloReply)) {
+		return false;
+	}
+	if (this.getMessage() !== other.getMessage()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+HelloReply.prototype.deepCopy = function() {
+	return copyJSON(this.jsonData_);
+};
+
+/**
+ * @return {!HelloReply} A copy of the message, sharing no data with it.
+ */
+HelloReply.prototype.clone = function() {
+	return new HelloReply(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!HelloReply} other The other message.
+ */
+HelloReply.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["message"];
+	if (other.hasMessage()) {
+		this.jsonData_["message"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+HelloReply.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = fieldMaskPaths(paths, "message");
+	if (p !== true) {
+		delete this.jsonData_["message"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!HelloReply} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+HelloReply.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getMessage() !== other.getMessage()) {
+		paths.push("message");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+HelloReply.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+HelloReply.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [verifyError(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	verifyValue(errors, json["message"], prefix + "message", verifyString);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+HelloReply.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	HelloReply.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!HelloReply} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+HelloReply.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["message"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!HelloReply} The message.
+ */
+HelloReply.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return HelloReply.deserializeBinaryFromReader(new HelloReply({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!HelloReply} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!HelloReply} The message.
+ */
+HelloReply.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["message"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Greets people.
+ *
+ * A client of the test.svc.Greeter service.
+ * @param {!GreeterClient.Transport|string} transport
+ *     The transport sending the requests, or the base URL of the JSON-over-HTTP
+ *     gateway.
+ * @constructor
+ */
+export const GreeterClient = function(transport) {
+	/**
+	 * @private {!GreeterClient.Transport}
+	 */
+	this.transport_ = typeof transport === 'string' ? fetchTransport(transport) : transport;
+};
+
+/**
+ * The transport of the client, a local fake or one created by the
+ * transport factories.
+ * @typedef {{call: function(string, string, !Object, ?Object=): !Promise.<*>, stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}}
+ */
+GreeterClient.Transport;
+
+/**
+ * Creates the transport sending the requests to a grpc-web server or proxy,
+ * in the binary format or, with text set, the base64 text format of the
+ * grpc-web protocol. It supports server-streaming RPCs.
+ * @param {string} baseUrl The base URL of the server.
+ * @param {boolean=} opt_text Whether to use the text format.
+ * @return {!GreeterClient.Transport} The transport.
+ */
+GreeterClient.grpcWebTransport = function(baseUrl, opt_text) {
+	return grpcWebTransport(baseUrl, opt_text);
+};
+
+/**
+ * Says hello.
+ * @param {!HelloRequest} request The request.
+ * @return {!Promise.<!HelloReply>} The response.
+ */
+GreeterClient.prototype.sayHello = function(request) {
+	return this.transport_.call('test.svc.Greeter', 'SayHello', request.getJsonData(), messageCodec(HelloRequest, HelloReply)).then(function(data) {
+		return new HelloReply(data);
+	});
+};
+
+/**
+ * @return {!Promise.<void>} The response.
+ */
+GreeterClient.prototype.ping = function() {
+	return this.transport_.call('test.svc.Greeter', 'Ping', {}, messageCodec(null, null)).then(function() {});
+};
+
+/**
+ * @param {!HelloRequest} request The request.
+ * @param {function(!HelloReply)} onMessage Called with each response.
+ * @param {function(Error)=} opt_onEnd Called when the stream ends, with the
+ *     error ending it or null.
+ * @return {function()} The function cancelling the stream.
+ */
+GreeterClient.prototype.watch = function(request, onMessage, opt_onEnd) {
+	return this.transport_.stream('test.svc.Greeter', 'Watch', request.getJsonData(), messageCodec(HelloRequest, HelloReply), function(data) {
+		onMessage(new HelloReply(data));
+	}, opt_onEnd || function() {});
+};
+
+// The client-streaming RPC Upload has no method: neither grpc-web nor
+// the JSON-over-HTTP gateways stream requests.
+
+// The bidirectional streaming RPC Chat has no method: neither grpc-web nor
+// the JSON-over-HTTP gateways stream requests.
+
+/**
+ * Concatenates two byte arrays.
+ * @param {!Uint8Array} a The first bytes.
+ * @param {!Uint8Array} b The second bytes.
+ * @return {!Uint8Array} The concatenation.
+ */
+function concatBytes(a, b) {
+	var bytes = new Uint8Array(a.length + b.length);
+	bytes.set(a);
+	bytes.set(b, a.length);
+	return bytes;
+}
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+function copyJSON(v) {
+	if (Array.isArray(v)) {
+		return v.map(copyJSON);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = copyJSON(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+}
+
+/**
+ * Creates the transport POSTing the JSON data of the requests to a
+ * JSON-over-HTTP gateway, at the base URL followed by "/<service>/<method>".
+ * The gateway can't stream the responses.
+ * @param {string} baseUrl The base URL of the gateway.
+ * @return {!{call: function(string, string, !Object, ?Object=): !Promise.<*>, stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}} The transport.
+ */
+function fetchTransport(baseUrl) {
+	return {
+		call: function(service, method, data) {
+			return fetch(baseUrl + '/' + service + '/' + method, {
+				method: 'POST',
+				headers: {'Content-Type': 'application/json'},
+				body: JSON.stringify(data)
+			}).then(function(response) {
+				if (!response.ok) {
+					throw new Error(service + '/' + method + ': HTTP status ' + response.status);
+				}
+				return response.json();
+			});
+		},
+		stream: unsupportedStream
+	};
+}
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+function fieldMaskPaths(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+}
+
+/**
+ * Creates the error of a failed RPC, with the gRPC status code in code
+ * and its name, like NOT_FOUND, prefixed to the message.
+ * @param {number} code The gRPC status code.
+ * @param {string} message The status message.
+ * @return {!Error} The error.
+ */
+function grpcWebError(code, message) {
+	var names = ['OK', 'CANCELLED', 'UNKNOWN', 'INVALID_ARGUMENT', 'DEADLINE_EXCEEDED',
+		'NOT_FOUND', 'ALREADY_EXISTS', 'PERMISSION_DENIED', 'RESOURCE_EXHAUSTED',
+		'FAILED_PRECONDITION', 'ABORTED', 'OUT_OF_RANGE', 'UNIMPLEMENTED', 'INTERNAL',
+		'UNAVAILABLE', 'DATA_LOSS', 'UNAUTHENTICATED'];
+	var error = new Error((names[code] || 'UNKNOWN') + ': ' + message);
+	error.code = code;
+	return error;
+}
+
+/**
+ * Frames a message in the grpc-web protocol, prefixing the flag byte, 0
+ * for messages, and the length of the message as a 4-byte big-endian integer.
+ * @param {!Uint8Array} bytes The serialized message.
+ * @return {!Uint8Array} The frame.
+ */
+function grpcWebFrame(bytes) {
+	var frame = new Uint8Array(5 + bytes.length);
+	new DataView(frame.buffer).setUint32(1, bytes.length);
+	frame.set(bytes, 5);
+	return frame;
+}
+
+/**
+ * Maps the HTTP status of a failed grpc-web response without
+ * grpc-status to the gRPC status code.
+ * @param {number} status The HTTP status.
+ * @return {number} The gRPC status code.
+ */
+function grpcWebHttpStatus(status) {
+	switch (status) {
+		case 400:
+			return 13;
+		case 401:
+			return 16;
+		case 403:
+			return 7;
+		case 404:
+			return 12;
+		case 429:
+		case 502:
+		case 503:
+		case 504:
+			return 14;
+	}
+	return 2;
+}
+
+/**
+ * Creates the parser of the frames of a grpc-web response, given its
+ * body in chunks. It passes the messages to onMessage and the trailers, the frame
+ * with the 0x80 flag, to onTrailers. In the text format, the body is base64,
+ * possibly in several padded parts.
+ * @param {boolean} text Whether the body is in the text format.
+ * @param {function(!Uint8Array)} onMessage Called with each message.
+ * @param {function(!Object.<string, string>)} onTrailers Called with the trailers.
+ * @return {function(!Uint8Array)} The function parsing the next chunk.
+ */
+function grpcWebParser(text, onMessage, onTrailers) {
+	var buffer = new Uint8Array(0);
+	var pending = '';
+	return function(chunk) {
+		if (text) {
+			// Decode the complete groups of 4 characters, part by part.
+			pending += new TextDecoder().decode(chunk);
+			var n = pending.length - pending.length % 4;
+			var parts = pending.substring(0, n).match(/[^=]+=*|=+/g) || [];
+			pending = pending.substring(n);
+			chunk = new Uint8Array(0);
+			for (var i = 0; i < parts.length; i++) {
+				chunk = concatBytes(chunk, jspb.Message.bytesAsU8(parts[i]));
+			}
+		}
+		buffer = concatBytes(buffer, chunk);
+		while (buffer.length >= 5) {
+			var length = new DataView(buffer.buffer, buffer.byteOffset).getUint32(1);
+			if (buffer.length < 5 + length) {
+				break;
+			}
+			var payload = buffer.subarray(5, 5 + length);
+			if (buffer[0] & 0x80) {
+				var trailers = {};
+				new TextDecoder().decode(payload).split('\r\n').forEach(function(line) {
+					var colon = line.indexOf(':');
+					if (colon > 0) {
+						trailers[line.substring(0, colon).trim().toLowerCase()] = line.substring(colon + 1).trim();
+					}
+				});
+				onTrailers(trailers);
+			} else {
+				onMessage(payload);
+			}
+			buffer = buffer.subarray(5 + length);
+		}
+	};
+}
+
+/**
+ * Converts the grpc-status and grpc-message of a grpc-web response
+ * into its error, or null for OK.
+ * @param {?string|undefined} status The status code.
+ * @param {?string|undefined} message The percent-encoded status message.
+ * @return {Error} The error, or null.
+ */
+function grpcWebStatus(status, message) {
+	if (status == null) {
+		return grpcWebError(13, 'missing grpc-status');
+	}
+	var code = Number(status);
+	if (code === 0) {
+		return null;
+	}
+	return grpcWebError(isNaN(code) ? 2 : code, message ? decodeURIComponent(message) : '');
+}
+
+/**
+ * Creates the transport sending the requests with the grpc-web
+ * protocol, in the binary format or, with text set, the base64 text format, to
+ * the base URL followed by "/<service>/<method>". It uses fetch and, to cancel
+ * the streams, AbortController. The failed RPCs end with errors carrying the
+ * gRPC status code in code.
+ * @param {string} baseUrl The base URL of the server.
+ * @param {boolean=} opt_text Whether to use the text format.
+ * @return {!{call: function(string, string, !Object, ?Object=): !Promise.<*>, stream: (undefined|function(string, string, !Object, ?Object, function(*), function(Error)): function())}} The transport.
+ */
+function grpcWebTransport(baseUrl, opt_text) {
+	var contentType = opt_text ? 'application/grpc-web-text' : 'application/grpc-web+proto';
+	var transport = {
+		call: function(service, method, data, codec) {
+			return new Promise(function(resolve, reject) {
+				var responses = [];
+				transport.stream(service, method, data, codec || null, function(data) {
+					responses.push(data);
+				}, function(error) {
+					if (error) {
+						reject(error);
+					} else if (responses.length != 1) {
+						reject(grpcWebError(13, 'expected 1 response, got ' + responses.length));
+					} else {
+						resolve(responses[0]);
+					}
+				});
+			});
+		},
+		stream: function(service, method, data, codec, onData, onEnd) {
+			var ended = false;
+			var end = function(error) {
+				if (!ended) {
+					ended = true;
+					onEnd(error);
+				}
+			};
+			if (!codec) {
+				end(grpcWebError(12, service + '/' + method + ' has no binary serialization'));
+				return function() {};
+			}
+			var controller = typeof AbortController != 'undefined' ? new AbortController() : null;
+			var frame = grpcWebFrame(codec.serialize(data));
+			fetch(baseUrl + '/' + service + '/' + method, {
+				method: 'POST',
+				headers: {'Content-Type': contentType, 'Accept': contentType, 'X-Grpc-Web': '1'},
+				body: opt_text ? jspb.Message.bytesAsB64(frame) : frame,
+				signal: controller ? controller.signal : undefined
+			}).then(function(response) {
+				if (response.headers.get('grpc-status') != null) {
+					// A trailers-only response.
+					end(grpcWebStatus(response.headers.get('grpc-status'), response.headers.get('grpc-message')));
+					return;
+				}
+				if (!response.ok) {
+					end(grpcWebError(grpcWebHttpStatus(response.status), 'HTTP status ' + response.status));
+					return;
+				}
+				var parse = grpcWebParser(!!opt_text, function(bytes) {
+					if (!ended) {
+						onData(codec.deserialize(bytes));
+					}
+				}, function(trailers) {
+					end(grpcWebStatus(trailers['grpc-status'], trailers['grpc-message']));
+				});
+				var reader = response.body.getReader();
+				var pump = function() {
+					return reader.read().then(function(chunk) {
+						if (chunk.done) {
+							end(grpcWebError(13, 'missing trailers'));
+						} else if (!ended) {
+							parse(chunk.value);
+							return pump();
+						}
+					});
+				};
+				return pump();
+			}).catch(function(error) {
+				end(grpcWebError(14, String(error && error.message || error)));
+			});
+			return function() {
+				end(grpcWebError(1, 'cancelled'));
+				if (controller) {
+					controller.abort();
+				}
+			};
+		}
+	};
+	return transport;
+}
+
+/**
+ * Creates the codec converting the JSON data of the requests and the
+ * responses of a method to and from the binary wire format. A null class stands
+ * for google.protobuf.Empty.
+ * @param {?Function} requestClass The class of the requests.
+ * @param {?Function} responseClass The class of the responses.
+ * @return {{serialize: function(!Object): !Uint8Array, deserialize: function(!Uint8Array): !Object}}
+ *     The codec.
+ */
+function messageCodec(requestClass, responseClass) {
+	return {
+		serialize: function(data) {
+			return requestClass ? new requestClass(data).serializeBinary() : new Uint8Array(0);
+		},
+		deserialize: function(bytes) {
+			return responseClass ? responseClass.deserializeBinary(bytes).getJsonData() : {};
+		}
+	};
+}
+
+/**
+ * Ends with an error the streams of the transports not supporting
+ * server-streaming RPCs.
+ * @param {string} service The full name of the service.
+ * @param {string} method The name of the method.
+ * @param {!Object} data The JSON data of the request.
+ * @param {?Object} codec The binary codec of the messages.
+ * @param {function(*)} onData Called with the JSON data of each response.
+ * @param {function(Error)} onEnd Called when the stream ends.
+ * @return {function()} The function cancelling the stream.
+ */
+function unsupportedStream(service, method, data, codec, onData, onEnd) {
+	onEnd(new Error(service + '/' + method + ': the transport doesn\'t support streaming'));
+	return function() {};
+}
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+function verifyError(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+}
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+function verifyString(v, path) {
+	return typeof v === 'string' ? [] : [verifyError(path, 'a string', v)];
+}
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+function verifyValue(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+}
+
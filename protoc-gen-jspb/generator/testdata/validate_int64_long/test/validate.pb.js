@@ -0,0 +1,1429 @@
+// Code generated by protoc-gen-js.
+// source: test/validate.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.validate.Status');
+goog.provide('test.validate.StatusUtil');
+goog.provide('test.validate.Address');
+goog.provide('test.validate.User');
+
+goog.require('goog.array');
+goog.require('goog.math.Long');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @enum {number}
+ */
+test.validate.Status = {
+	UNKNOWN: 0,
+	ACTIVE: 1
+};
+
+/**
+ * The helpers of the test.validate.Status enum.
+ * @const
+ */
+test.validate.StatusUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		0: 'UNKNOWN',
+		1: 'ACTIVE'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return test.validate.StatusUtil.isValid(value) ? test.validate.StatusUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?test.validate.Status} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(test.validate.Status, name) ? test.validate.Status[name] : null;
+	},
+	/**
+	 * @return {!Array.<test.validate.Status>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [0, 1];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(test.validate.StatusUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.validate.Address = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.validate.Address.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.validate.Address.TYPE_NAME = 'test.validate.Address';
+
+/**
+ * @return {string}
+ */
+test.validate.Address.prototype.getCity = function() {
+	var v = this.jsonData_["city"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} city The city.
+ */
+test.validate.Address.prototype.setCity = function(city) {
+	this.jsonData_["city"] = city;
+};
+
+/**
+ * @return {boolean} Whether the city is set to a value other than the default.
+ */
+test.validate.Address.prototype.hasCity = function() {
+	return this.getCity() !== '';
+};
+
+/**
+ * Clears the city.
+ */
+test.validate.Address.prototype.clearCity = function() {
+	delete this.jsonData_["city"];
+};
+
+/**
+ * @return {string}
+ */
+test.validate.Address.prototype.getCode = function() {
+	var v = this.jsonData_["code"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} code The code.
+ */
+test.validate.Address.prototype.setCode = function(code) {
+	this.jsonData_["code"] = code;
+};
+
+/**
+ * @return {boolean} Whether the code is set to a value other than the default.
+ */
+test.validate.Address.prototype.hasCode = function() {
+	return this.getCode() !== '';
+};
+
+/**
+ * Clears the code.
+ */
+test.validate.Address.prototype.clearCode = function() {
+	delete this.jsonData_["code"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.validate.Address.prototype.equals = function(other) {
+	if (!(other instanceof test.validate.Address)) {
+		return false;
+	}
+	if (this.getCity() !== other.getCity()) {
+		return false;
+	}
+	if (this.getCode() !== other.getCode()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.validate.Address.prototype.deepCopy = function() {
+	return test.validate.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.validate.Address} A copy of the message, sharing no data with it.
+ */
+test.validate.Address.prototype.clone = function() {
+	return new test.validate.Address(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.validate.Address} other The other message.
+ */
+test.validate.Address.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["city"];
+	if (other.hasCity()) {
+		this.jsonData_["city"] = v;
+	}
+	v = other.jsonData_["code"];
+	if (other.hasCode()) {
+		this.jsonData_["code"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.validate.Address.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.validate.fieldMaskPaths_(paths, "city");
+	if (p !== true) {
+		delete this.jsonData_["city"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "code");
+	if (p !== true) {
+		delete this.jsonData_["code"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.validate.Address} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.validate.Address.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getCity() !== other.getCity()) {
+		paths.push("city");
+	}
+	if (this.getCode() !== other.getCode()) {
+		paths.push("code");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.validate.Address.prototype.validate = function() {
+	var violations = [];
+	var v;
+	v = this.getCity();
+	if (test.validate.runeCount_(v) < 1) {
+		violations.push({field: "city", rule: "string.min_len", message: "value length must be at least 1 characters"});
+	}
+	v = this.getCode();
+	if (!new RegExp("^([A-Za-z\\u{17f}\\u{212a}]{2})-[0-9]+$", 'u').test(v)) {
+		violations.push({field: "code", rule: "string.pattern", message: "value does not match regex pattern (?i)^(?P<country>[a-z]{2})-\\d+\\z"});
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.validate.Address.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.validate.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.validate.verifyValue_(errors, json["city"], prefix + "city", test.validate.verifyString_);
+	test.validate.verifyValue_(errors, json["code"], prefix + "code", test.validate.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.validate.Address.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.validate.Address.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.validate.Address} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.validate.Address.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["city"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+	v = message.jsonData_["code"];
+	if (v != null) {
+		writer.writeString(2, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.validate.Address} The message.
+ */
+test.validate.Address.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.validate.Address.deserializeBinaryFromReader(new test.validate.Address({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.validate.Address} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.validate.Address} The message.
+ */
+test.validate.Address.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["city"] = value;
+			break;
+		case 2:
+			value = reader.readString();
+			message.jsonData_["code"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.validate.User = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.validate.User.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.validate.User.TYPE_NAME = 'test.validate.User';
+
+/**
+ * @return {string}
+ */
+test.validate.User.prototype.getName = function() {
+	var v = this.jsonData_["name"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} name The name.
+ */
+test.validate.User.prototype.setName = function(name) {
+	this.jsonData_["name"] = name;
+};
+
+/**
+ * @return {boolean} Whether the name is set to a value other than the default.
+ */
+test.validate.User.prototype.hasName = function() {
+	return this.getName() !== '';
+};
+
+/**
+ * Clears the name.
+ */
+test.validate.User.prototype.clearName = function() {
+	delete this.jsonData_["name"];
+};
+
+/**
+ * @return {number}
+ */
+test.validate.User.prototype.getAge = function() {
+	var v = this.jsonData_["age"];
+	return v != null ? Number(v) : 0;
+};
+
+/**
+ * @param {number} age The age.
+ */
+test.validate.User.prototype.setAge = function(age) {
+	this.jsonData_["age"] = age;
+};
+
+/**
+ * @return {boolean} Whether the age is set to a value other than the default.
+ */
+test.validate.User.prototype.hasAge = function() {
+	return this.getAge() !== 0;
+};
+
+/**
+ * Clears the age.
+ */
+test.validate.User.prototype.clearAge = function() {
+	delete this.jsonData_["age"];
+};
+
+/**
+ * @return {number}
+ */
+test.validate.User.prototype.getScore = function() {
+	var v = this.jsonData_["score"];
+	return v != null ? Number(v) : 0;
+};
+
+/**
+ * @param {number} score The score.
+ */
+test.validate.User.prototype.setScore = function(score) {
+	this.jsonData_["score"] = (isFinite(score) ? score : String(score));
+};
+
+/**
+ * @return {boolean} Whether the score is set to a value other than the default.
+ */
+test.validate.User.prototype.hasScore = function() {
+	return this.getScore() !== 0;
+};
+
+/**
+ * Clears the score.
+ */
+test.validate.User.prototype.clearScore = function() {
+	delete this.jsonData_["score"];
+};
+
+/**
+ * @return {test.validate.Status}
+ */
+test.validate.User.prototype.getStatus = function() {
+	var v = this.jsonData_["status"];
+	return v != null ? test.validate.enumFromJSON_(test.validate.StatusUtil, v, test.validate.Status.UNKNOWN) : 0;
+};
+
+/**
+ * @param {test.validate.Status} status The status.
+ */
+test.validate.User.prototype.setStatus = function(status) {
+	this.jsonData_["status"] = (test.validate.StatusUtil.nameOf(status) || status);
+};
+
+/**
+ * @return {boolean} Whether the status is set to a value other than the default.
+ */
+test.validate.User.prototype.hasStatus = function() {
+	return this.getStatus() !== 0;
+};
+
+/**
+ * Clears the status.
+ */
+test.validate.User.prototype.clearStatus = function() {
+	delete this.jsonData_["status"];
+};
+
+/**
+ * @return {test.validate.Address}
+ */
+test.validate.User.prototype.getAddress = function() {
+	if (this.address_) {
+		return this.address_;
+	}
+	var v = this.jsonData_["address"];
+	if (v) {
+		/** @private {test.validate.Address} */
+		this.address_ = new test.validate.Address(v);
+		return this.address_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.validate.Address} address The address.
+ */
+test.validate.User.prototype.setAddress = function(address) {
+	this.jsonData_["address"] = address.getJsonData();
+	this.address_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the address is set.
+ */
+test.validate.User.prototype.hasAddress = function() {
+	return this.jsonData_["address"] != null;
+};
+
+/**
+ * Clears the address.
+ */
+test.validate.User.prototype.clearAddress = function() {
+	delete this.jsonData_["address"];
+	this.address_ = undefined;
+};
+
+/**
+ * @return {Array.<string>}
+ */
+test.validate.User.prototype.getTags = function() {
+	return this.jsonData_["tags"] || [];
+};
+
+/**
+ * @param {Array.<string>} tags The tags.
+ */
+test.validate.User.prototype.setTags = function(tags) {
+	this.jsonData_["tags"] = tags;
+};
+
+/**
+ * @return {Array.<test.validate.Address>}
+ */
+test.validate.User.prototype.getOthers = function() {
+	if (this.others_) {
+		return this.others_;
+	}
+	var v = this.jsonData_["others"];
+	if (v) {
+		/** @private {Array.<test.validate.Address>} */
+		this.others_ = [];
+		goog.array.forEach(v, function(__item, __index) {
+			this.others_.push(new test.validate.Address(__item));
+		}, this);
+		return this.others_;
+	}
+	return [];
+};
+
+/**
+ * @param {Array.<test.validate.Address>} others The others.
+ */
+test.validate.User.prototype.setOthers = function(others) {
+	this.jsonData_["others"] = (others || []).map(function(__item) {
+		return __item.getJsonData();
+	});
+	this.others_ = undefined;
+};
+
+/**
+ * @return {goog.math.Long}
+ */
+test.validate.User.prototype.getId = function() {
+	var v = this.jsonData_["id"];
+	return v != null ? goog.math.Long.fromString(String(v)) : goog.math.Long.getZero();
+};
+
+/**
+ * @param {goog.math.Long} id The id.
+ */
+test.validate.User.prototype.setId = function(id) {
+	this.jsonData_["id"] = id.toString();
+};
+
+/**
+ * @return {boolean} Whether the id is set to a value other than the default.
+ */
+test.validate.User.prototype.hasId = function() {
+	return !this.getId().isZero();
+};
+
+/**
+ * Clears the id.
+ */
+test.validate.User.prototype.clearId = function() {
+	delete this.jsonData_["id"];
+};
+
+/**
+ * @return {string}
+ */
+test.validate.User.prototype.getNickname = function() {
+	var v = this.jsonData_["nickname"];
+	return v != null ? v : '';
+};
+
+/**
+ * @param {string} nickname The nickname.
+ */
+test.validate.User.prototype.setNickname = function(nickname) {
+	this.jsonData_["nickname"] = nickname;
+};
+
+/**
+ * @return {boolean} Whether the nickname is set.
+ */
+test.validate.User.prototype.hasNickname = function() {
+	return this.jsonData_["nickname"] != null;
+};
+
+/**
+ * Clears the nickname.
+ */
+test.validate.User.prototype.clearNickname = function() {
+	delete this.jsonData_["nickname"];
+};
+
+/**
+ * @return {test.validate.Address}
+ */
+test.validate.User.prototype.getPrevious = function() {
+	if (this.previous_) {
+		return this.previous_;
+	}
+	var v = this.jsonData_["previous"];
+	if (v) {
+		/** @private {test.validate.Address} */
+		this.previous_ = new test.validate.Address(v);
+		return this.previous_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.validate.Address} previous The previous.
+ */
+test.validate.User.prototype.setPrevious = function(previous) {
+	this.jsonData_["previous"] = previous.getJsonData();
+	this.previous_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the previous is set.
+ */
+test.validate.User.prototype.hasPrevious = function() {
+	return this.jsonData_["previous"] != null;
+};
+
+/**
+ * Clears the previous.
+ */
+test.validate.User.prototype.clearPrevious = function() {
+	delete this.jsonData_["previous"];
+	this.previous_ = undefined;
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.validate.User.prototype.equals = function(other) {
+	if (!(other instanceof test.validate.User)) {
+		return false;
+	}
+	if (this.getName() !== other.getName()) {
+		return false;
+	}
+	if (this.getAge() !== other.getAge()) {
+		return false;
+	}
+	if (!test.validate.floatEquals_(this.getScore(), other.getScore())) {
+		return false;
+	}
+	if (this.getStatus() !== other.getStatus()) {
+		return false;
+	}
+	if (!test.validate.messageEquals_(this.getAddress(), other.getAddress())) {
+		return false;
+	}
+	if (!test.validate.arrayEquals_(this.getTags(), other.getTags(), null)) {
+		return false;
+	}
+	if (!test.validate.arrayEquals_(this.getOthers(), other.getOthers(), test.validate.messageEquals_)) {
+		return false;
+	}
+	if (!test.validate.longEquals_(this.getId(), other.getId())) {
+		return false;
+	}
+	if (this.getNickname() !== other.getNickname()) {
+		return false;
+	}
+	if (!test.validate.messageEquals_(this.getPrevious(), other.getPrevious())) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.validate.User.prototype.deepCopy = function() {
+	return test.validate.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.validate.User} A copy of the message, sharing no data with it.
+ */
+test.validate.User.prototype.clone = function() {
+	return new test.validate.User(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.validate.User} other The other message.
+ */
+test.validate.User.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["name"];
+	if (other.hasName()) {
+		this.jsonData_["name"] = v;
+	}
+	v = other.jsonData_["age"];
+	if (other.hasAge()) {
+		this.jsonData_["age"] = v;
+	}
+	v = other.jsonData_["score"];
+	if (other.hasScore()) {
+		this.jsonData_["score"] = v;
+	}
+	v = other.jsonData_["status"];
+	if (other.hasStatus()) {
+		this.jsonData_["status"] = v;
+	}
+	v = other.jsonData_["address"];
+	if (other.hasAddress()) {
+		if (this.hasAddress()) {
+			this.getAddress().mergeFrom(other.getAddress());
+		} else {
+			this.jsonData_["address"] = test.validate.copyJSON_(v);
+		}
+		this.address_ = undefined;
+	}
+	v = other.jsonData_["tags"];
+	if (v != null && v.length) {
+		this.jsonData_["tags"] = (this.jsonData_["tags"] || []).concat(v);
+	}
+	v = other.jsonData_["others"];
+	if (v != null && v.length) {
+		this.jsonData_["others"] = (this.jsonData_["others"] || []).concat(test.validate.copyJSON_(v));
+		this.others_ = undefined;
+	}
+	v = other.jsonData_["id"];
+	if (other.hasId()) {
+		this.jsonData_["id"] = v;
+	}
+	v = other.jsonData_["nickname"];
+	if (other.hasNickname()) {
+		this.jsonData_["nickname"] = v;
+	}
+	v = other.jsonData_["previous"];
+	if (other.hasPrevious()) {
+		if (this.hasPrevious()) {
+			this.getPrevious().mergeFrom(other.getPrevious());
+		} else {
+			this.jsonData_["previous"] = test.validate.copyJSON_(v);
+		}
+		this.previous_ = undefined;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.validate.User.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.validate.fieldMaskPaths_(paths, "name");
+	if (p !== true) {
+		delete this.jsonData_["name"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "age");
+	if (p !== true) {
+		delete this.jsonData_["age"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "score");
+	if (p !== true) {
+		delete this.jsonData_["score"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "status");
+	if (p !== true) {
+		delete this.jsonData_["status"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "address");
+	if (p !== true) {
+		if (p.length && this.hasAddress()) {
+			this.getAddress().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["address"];
+			this.address_ = undefined;
+		}
+	}
+	p = test.validate.fieldMaskPaths_(paths, "tags");
+	if (p !== true) {
+		delete this.jsonData_["tags"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "others");
+	if (p !== true) {
+		delete this.jsonData_["others"];
+		this.others_ = undefined;
+	}
+	p = test.validate.fieldMaskPaths_(paths, "id");
+	if (p !== true) {
+		delete this.jsonData_["id"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "nickname");
+	if (p !== true) {
+		delete this.jsonData_["nickname"];
+	}
+	p = test.validate.fieldMaskPaths_(paths, "previous");
+	if (p !== true) {
+		if (p.length && this.hasPrevious()) {
+			this.getPrevious().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["previous"];
+			this.previous_ = undefined;
+		}
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.validate.User} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.validate.User.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.getName() !== other.getName()) {
+		paths.push("name");
+	}
+	if (this.getAge() !== other.getAge()) {
+		paths.push("age");
+	}
+	if (!test.validate.floatEquals_(this.getScore(), other.getScore())) {
+		paths.push("score");
+	}
+	if (this.getStatus() !== other.getStatus()) {
+		paths.push("status");
+	}
+	if (this.hasAddress() && other.hasAddress()) {
+		test.validate.appendPaths_(paths, "address.", this.getAddress().diffFieldMask(other.getAddress()));
+	} else if (this.hasAddress() !== other.hasAddress()) {
+		paths.push("address");
+	}
+	if (!test.validate.arrayEquals_(this.getTags(), other.getTags(), null)) {
+		paths.push("tags");
+	}
+	if (!test.validate.arrayEquals_(this.getOthers(), other.getOthers(), test.validate.messageEquals_)) {
+		paths.push("others");
+	}
+	if (!test.validate.longEquals_(this.getId(), other.getId())) {
+		paths.push("id");
+	}
+	if (this.hasNickname() !== other.hasNickname() || this.getNickname() !== other.getNickname()) {
+		paths.push("nickname");
+	}
+	if (this.hasPrevious() && other.hasPrevious()) {
+		test.validate.appendPaths_(paths, "previous.", this.getPrevious().diffFieldMask(other.getPrevious()));
+	} else if (this.hasPrevious() !== other.hasPrevious()) {
+		paths.push("previous");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.validate.User.prototype.validate = function() {
+	var violations = [];
+	var v;
+	v = this.getName();
+	if (test.validate.runeCount_(v) < 2) {
+		violations.push({field: "name", rule: "string.min_len", message: "value length must be at least 2 characters"});
+	}
+	if (test.validate.runeCount_(v) > 20) {
+		violations.push({field: "name", rule: "string.max_len", message: "value length must be at most 20 characters"});
+	}
+	if (!new RegExp("^[a-z]+$", 'u').test(v)) {
+		violations.push({field: "name", rule: "string.pattern", message: "value does not match regex pattern ^[a-z]+$"});
+	}
+	v = this.getAge();
+	if (!(v >= 0)) {
+		violations.push({field: "age", rule: "int32.gte", message: "value must be greater than or equal to 0"});
+	}
+	if (!(v < 150)) {
+		violations.push({field: "age", rule: "int32.lt", message: "value must be less than 150"});
+	}
+	v = this.getScore();
+	if (!(v > 10 || v < 0)) {
+		violations.push({field: "score", rule: "double.gt", message: "value must be greater than 10 or less than 0"});
+	}
+	v = this.getStatus();
+	if (!test.validate.StatusUtil.isValid(v)) {
+		violations.push({field: "status", rule: "enum.defined_only", message: "value must be one of the defined enum values"});
+	}
+	if (this.hasAddress()) {
+		v = this.getAddress();
+		test.validate.nestedViolations_(violations, "address", v.validate());
+	} else {
+		violations.push({field: "address", rule: "required", message: "value is required"});
+	}
+	v = this.getTags();
+	if (v.length < 1) {
+		violations.push({field: "tags", rule: "repeated.min_items", message: "value must contain at least 1 item(s)"});
+	}
+	if (v.length > 3) {
+		violations.push({field: "tags", rule: "repeated.max_items", message: "value must contain no more than 3 item(s)"});
+	}
+	goog.array.forEach(v, function(__item, __index) {
+		if (test.validate.runeCount_(__item) !== 3) {
+			violations.push({field: "tags[" + __index + "]", rule: "string.len", message: "value length must be 3 characters"});
+		}
+	}, this);
+	v = this.getOthers();
+	goog.array.forEach(v, function(__item, __index) {
+		test.validate.nestedViolations_(violations, "others[" + __index + "]", __item.validate());
+	}, this);
+	if (!this.hasId()) {
+		violations.push({field: "id", rule: "required", message: "value is required"});
+	}
+	v = this.getId();
+	if (!(v.toNumber() > 0)) {
+		violations.push({field: "id", rule: "int64.gt", message: "value must be greater than 0"});
+	}
+	if (this.hasNickname()) {
+		v = this.getNickname();
+		if (test.validate.runeCount_(v) > 8) {
+			violations.push({field: "nickname", rule: "string.max_len", message: "value length must be at most 8 characters"});
+		}
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.validate.User.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.validate.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.validate.verifyValue_(errors, json["name"], prefix + "name", test.validate.verifyString_);
+	test.validate.verifyValue_(errors, json["age"], prefix + "age", test.validate.verifyInteger_(32, false, true));
+	test.validate.verifyValue_(errors, json["score"], prefix + "score", test.validate.verifyFloat_);
+	test.validate.verifyValue_(errors, json["status"], prefix + "status", test.validate.verifyEnum_(test.validate.StatusUtil, true, false));
+	test.validate.verifyValue_(errors, json["address"], prefix + "address", test.validate.Address.verify);
+	test.validate.verifyArray_(errors, json["tags"], prefix + "tags", test.validate.verifyString_);
+	test.validate.verifyArray_(errors, json["others"], prefix + "others", test.validate.Address.verify);
+	test.validate.verifyValue_(errors, json["id"], prefix + "id", test.validate.verifyDecimal_(false));
+	test.validate.verifyValue_(errors, json["nickname"], prefix + "nickname", test.validate.verifyString_);
+	test.validate.verifyValue_(errors, json["previous"], prefix + "previous", test.validate.Address.verify);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.validate.User.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.validate.User.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.validate.User} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.validate.User.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["name"];
+	if (v != null) {
+		writer.writeString(1, v);
+	}
+	v = message.jsonData_["age"];
+	if (v != null) {
+		writer.writeInt32(2, Number(v));
+	}
+	v = message.jsonData_["score"];
+	if (v != null) {
+		writer.writeDouble(3, Number(v));
+	}
+	v = message.jsonData_["status"];
+	if (v != null) {
+		writer.writeEnum(4, test.validate.enumFromJSON_(test.validate.StatusUtil, v, test.validate.Status.UNKNOWN));
+	}
+	v = message.jsonData_["address"];
+	if (v != null) {
+		writer.writeMessage(5, new test.validate.Address(v), test.validate.Address.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["tags"];
+	if (v != null) {
+		writer.writeRepeatedString(6, v);
+	}
+	v = message.jsonData_["others"];
+	if (v != null) {
+		goog.array.forEach(v, function(__item) {
+			writer.writeMessage(7, new test.validate.Address(__item), test.validate.Address.serializeBinaryToWriter);
+		}, this);
+	}
+	v = message.jsonData_["id"];
+	if (v != null) {
+		writer.writeInt64String(8, String(v));
+	}
+	v = message.jsonData_["nickname"];
+	if (v != null) {
+		writer.writeString(9, v);
+	}
+	v = message.jsonData_["previous"];
+	if (v != null) {
+		writer.writeMessage(10, new test.validate.Address(v), test.validate.Address.serializeBinaryToWriter);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.validate.User} The message.
+ */
+test.validate.User.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.validate.User.deserializeBinaryFromReader(new test.validate.User({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.validate.User} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.validate.User} The message.
+ */
+test.validate.User.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readString();
+			message.jsonData_["name"] = value;
+			break;
+		case 2:
+			value = reader.readInt32();
+			message.jsonData_["age"] = value;
+			break;
+		case 3:
+			value = reader.readDouble();
+			message.jsonData_["score"] = (isFinite(value) ? value : String(value));
+			break;
+		case 4:
+			value = reader.readEnum();
+			message.jsonData_["status"] = (test.validate.StatusUtil.nameOf(value) || value);
+			break;
+		case 5:
+			value = new test.validate.Address({});
+			reader.readMessage(value, test.validate.Address.deserializeBinaryFromReader);
+			message.jsonData_["address"] = value.getJsonData();
+			message.address_ = undefined;
+			break;
+		case 6:
+			value = [reader.readString()];
+			message.jsonData_["tags"] = (message.jsonData_["tags"] || []).concat(value);
+			break;
+		case 7:
+			value = new test.validate.Address({});
+			reader.readMessage(value, test.validate.Address.deserializeBinaryFromReader);
+			message.jsonData_["others"] = message.jsonData_["others"] || [];
+			message.jsonData_["others"].push(value.getJsonData());
+			break;
+		case 8:
+			value = reader.readInt64String();
+			message.jsonData_["id"] = value;
+			break;
+		case 9:
+			value = reader.readString();
+			message.jsonData_["nickname"] = value;
+			break;
+		case 10:
+			value = new test.validate.Address({});
+			reader.readMessage(value, test.validate.Address.deserializeBinaryFromReader);
+			message.jsonData_["previous"] = value.getJsonData();
+			message.previous_ = undefined;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.validate.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.validate.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.validate.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.validate.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.validate.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Converts an enum value from its canonical JSON form, the name of the value
+ * or its number, maybe as a decimal string, into its number.
+ * @param {{valueOf: function(string): ?number}} util The helpers of the enum.
+ * @param {*} v The JSON value.
+ * @param {number} def The default of the field, for the names the enum doesn't
+ *     define.
+ * @return {number} The number.
+ */
+test.validate.enumFromJSON_ = function(util, v, def) {
+	if (typeof v !== 'string') {
+		return /** @type {number} */ (v);
+	}
+	if (/^-?[0-9]+$/.test(v)) {
+		return Number(v);
+	}
+	var n = util.valueOf(v);
+	return n !== null ? n : def;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.validate.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two floating point numbers, NaN being equal to NaN.
+ * @param {?number} a The first number.
+ * @param {?number} b The second number.
+ * @return {boolean} Whether the numbers are equal.
+ */
+test.validate.floatEquals_ = function(a, b) {
+	return a === b || (a !== a && b !== b);
+};
+
+/**
+ * Compares two goog.math.Long values, or nulls.
+ * @param {?goog.math.Long} a The first value.
+ * @param {?goog.math.Long} b The second value.
+ * @return {boolean} Whether the values are equal.
+ */
+test.validate.longEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.validate.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.validate.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Counts the characters of a string, the Unicode code points rather than the
+ * UTF-16 code units.
+ * @param {string} s The string.
+ * @return {number} The number of characters.
+ */
+test.validate.runeCount_ = function(s) {
+	return s.replace(/[\uD800-\uDBFF][\uDC00-\uDFFF]/g, '_').length;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.validate.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.validate.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.validate.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.validate.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.validate.verifyEnum_ = function(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[test.validate.verifyError_(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [test.validate.verifyError_(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [test.validate.verifyError_(path, 'a value of the enum', v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.validate.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Checks that a JSON value is a number, or "NaN", "Infinity" or "-Infinity".
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.validate.verifyFloat_ = function(v, path) {
+	return typeof v === 'number' || v === 'NaN' || v === 'Infinity' || v === '-Infinity' ? [] :
+			[test.validate.verifyError_(path, 'a number', v)];
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.validate.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.validate.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.validate.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.validate.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.validate.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.validate.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,1219 @@
+// Code generated by protoc-gen-js.
+// source: test/verify.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.verify.Kind');
+goog.provide('test.verify.KindUtil');
+goog.provide('test.verify.Item');
+goog.provide('test.verify.Order');
+
+goog.require('goog.array');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @enum {number}
+ */
+test.verify.Kind = {
+	BOOK: 1,
+	DISC: 2
+};
+
+/**
+ * The helpers of the test.verify.Kind enum.
+ * @const
+ */
+test.verify.KindUtil = {
+	/**
+	 * The names of the values, by value. Aliases have the name of the first
+	 * value.
+	 * @const {!Object.<number, string>}
+	 */
+	names: {
+		1: 'BOOK',
+		2: 'DISC'
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {?string} The name of the value, or null if the enum doesn't
+	 *     define it.
+	 */
+	nameOf: function(value) {
+		return test.verify.KindUtil.isValid(value) ? test.verify.KindUtil.names[value] : null;
+	},
+	/**
+	 * @param {string} name The name of a value.
+	 * @return {?test.verify.Kind} The value, or null if the
+	 *     enum doesn't define the name.
+	 */
+	valueOf: function(name) {
+		return Object.prototype.hasOwnProperty.call(test.verify.Kind, name) ? test.verify.Kind[name] : null;
+	},
+	/**
+	 * @return {!Array.<test.verify.Kind>} The values, in the order of their
+	 *     declaration, without the aliases.
+	 */
+	values: function() {
+		return [1, 2];
+	},
+	/**
+	 * @param {number} value The value.
+	 * @return {boolean} Whether the enum defines the value.
+	 */
+	isValid: function(value) {
+		return Object.prototype.hasOwnProperty.call(test.verify.KindUtil.names, value);
+	}
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.verify.Item = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.verify.Item.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.verify.Item.TYPE_NAME = 'test.verify.Item';
+
+/**
+ * @return {number}
+ */
+test.verify.Item.prototype.getCount = function() {
+	var v = this.jsonData_["count"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} count The count.
+ */
+test.verify.Item.prototype.setCount = function(count) {
+	this.jsonData_["count"] = count;
+};
+
+/**
+ * @return {boolean} Whether the count is set.
+ */
+test.verify.Item.prototype.hasCount = function() {
+	return this.jsonData_["count"] != null;
+};
+
+/**
+ * Clears the count.
+ */
+test.verify.Item.prototype.clearCount = function() {
+	delete this.jsonData_["count"];
+};
+
+/**
+ * @return {test.verify.Kind}
+ */
+test.verify.Item.prototype.getKind = function() {
+	var v = this.jsonData_["kind"];
+	return v != null ? v : test.verify.Kind.BOOK;
+};
+
+/**
+ * @param {test.verify.Kind} kind The kind.
+ */
+test.verify.Item.prototype.setKind = function(kind) {
+	this.jsonData_["kind"] = kind;
+};
+
+/**
+ * @return {boolean} Whether the kind is set.
+ */
+test.verify.Item.prototype.hasKind = function() {
+	return this.jsonData_["kind"] != null;
+};
+
+/**
+ * Clears the kind.
+ */
+test.verify.Item.prototype.clearKind = function() {
+	delete this.jsonData_["kind"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.verify.Item.prototype.equals = function(other) {
+	if (!(other instanceof test.verify.Item)) {
+		return false;
+	}
+	if (this.getCount() !== other.getCount()) {
+		return false;
+	}
+	if (this.getKind() !== other.getKind()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.verify.Item.prototype.deepCopy = function() {
+	return test.verify.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.verify.Item} A copy of the message, sharing no data with it.
+ */
+test.verify.Item.prototype.clone = function() {
+	return new test.verify.Item(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.verify.Item} other The other message.
+ */
+test.verify.Item.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["count"];
+	if (other.hasCount()) {
+		this.jsonData_["count"] = v;
+	}
+	v = other.jsonData_["kind"];
+	if (other.hasKind()) {
+		this.jsonData_["kind"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.verify.Item.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.verify.fieldMaskPaths_(paths, "count");
+	if (p !== true) {
+		delete this.jsonData_["count"];
+	}
+	p = test.verify.fieldMaskPaths_(paths, "kind");
+	if (p !== true) {
+		delete this.jsonData_["kind"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.verify.Item} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.verify.Item.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasCount() !== other.hasCount() || this.getCount() !== other.getCount()) {
+		paths.push("count");
+	}
+	if (this.hasKind() !== other.hasKind() || this.getKind() !== other.getKind()) {
+		paths.push("kind");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.verify.Item.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.verify.Item.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.verify.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.verify.verifyValue_(errors, json["count"], prefix + "count", test.verify.verifyInteger_(32, true, false));
+	test.verify.verifyValue_(errors, json["kind"], prefix + "kind", test.verify.verifyEnum_(test.verify.KindUtil, false, true));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.verify.Item.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.verify.Item.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.verify.Item} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.verify.Item.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["count"];
+	if (v != null) {
+		writer.writeUint32(1, v);
+	}
+	v = message.jsonData_["kind"];
+	if (v != null) {
+		writer.writeEnum(2, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.verify.Item} The message.
+ */
+test.verify.Item.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.verify.Item.deserializeBinaryFromReader(new test.verify.Item({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.verify.Item} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.verify.Item} The message.
+ */
+test.verify.Item.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = reader.readUint32();
+			message.jsonData_["count"] = value;
+			break;
+		case 2:
+			value = reader.readEnum();
+			message.jsonData_["kind"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.verify.Order = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.verify.Order.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.verify.Order.TYPE_NAME = 'test.verify.Order';
+
+/**
+ * @return {test.verify.Item}
+ */
+test.verify.Order.prototype.getItem = function() {
+	if (this.item_) {
+		return this.item_;
+	}
+	var v = this.jsonData_["item"];
+	if (v) {
+		/** @private {test.verify.Item} */
+		this.item_ = new test.verify.Item(v);
+		return this.item_;
+	}
+	return undefined;
+};
+
+/**
+ * @param {test.verify.Item} item The item.
+ */
+test.verify.Order.prototype.setItem = function(item) {
+	this.jsonData_["item"] = item.getJsonData();
+	this.item_ = undefined;
+};
+
+/**
+ * @return {boolean} Whether the item is set.
+ */
+test.verify.Order.prototype.hasItem = function() {
+	return this.jsonData_["item"] != null;
+};
+
+/**
+ * Clears the item.
+ */
+test.verify.Order.prototype.clearItem = function() {
+	delete this.jsonData_["item"];
+	this.item_ = undefined;
+};
+
+/**
+ * @return {Array.<test.verify.Item>}
+ */
+test.verify.Order.prototype.getItems = function() {
+	if (this.items_) {
+		return this.items_;
+	}
+	var v = this.jsonData_["items"];
+	if (v) {
+		/** @private {Array.<test.verify.Item>} */
+		this.items_ = [];
+		goog.array.forEach(v, function(__item, __index) {
+			this.items_.push(new test.verify.Item(__item));
+		}, this);
+		return this.items_;
+	}
+	return [];
+};
+
+/**
+ * @param {Array.<test.verify.Item>} items The items.
+ */
+test.verify.Order.prototype.setItems = function(items) {
+	this.jsonData_["items"] = (items || []).map(function(__item) {
+		return __item.getJsonData();
+	});
+	this.items_ = undefined;
+};
+
+/**
+ * @return {Object.<string, test.verify.Item>}
+ */
+test.verify.Order.prototype.getByName = function() {
+	if (this.by_name_) {
+		return this.by_name_;
+	}
+	var v = this.jsonData_["by_name"];
+	if (v) {
+		/** @private {Object.<string, test.verify.Item>} */
+		this.by_name_ = {};
+		for (var __key in v) {
+			this.by_name_[__key] = new test.verify.Item(v[__key]);
+		}
+		return this.by_name_;
+	}
+	return {};
+};
+
+/**
+ * @param {Object.<string, test.verify.Item>} by_name The by_name.
+ */
+test.verify.Order.prototype.setByName = function(by_name) {
+	var __data = {};
+	for (var __key in by_name) {
+		__data[__key] = by_name[__key].getJsonData();
+	}
+	this.jsonData_["by_name"] = __data;
+	this.by_name_ = undefined;
+};
+
+/**
+ * @return {!Map.<string, test.verify.Item>}
+ */
+test.verify.Order.prototype.getByNameMap = function() {
+	var __map = new Map();
+	var __obj = this.getByName();
+	for (var __key in __obj) {
+		__map.set(__key, __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<string, test.verify.Item>} by_name The by_name.
+ */
+test.verify.Order.prototype.setByNameMap = function(by_name) {
+	var __obj = {};
+	by_name.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setByName(__obj);
+};
+
+/**
+ * @return {Array.<number>}
+ */
+test.verify.Order.prototype.getKinds = function() {
+	return this.jsonData_["kinds"] || [];
+};
+
+/**
+ * @param {Array.<number>} kinds The kinds.
+ */
+test.verify.Order.prototype.setKinds = function(kinds) {
+	this.jsonData_["kinds"] = kinds;
+};
+
+/**
+ * @return {Object.<string, number>}
+ */
+test.verify.Order.prototype.getTotals = function() {
+	return this.jsonData_["totals"] || {};
+};
+
+/**
+ * @param {Object.<string, number>} totals The totals.
+ */
+test.verify.Order.prototype.setTotals = function(totals) {
+	this.jsonData_["totals"] = totals;
+};
+
+/**
+ * @return {!Map.<number, number>}
+ */
+test.verify.Order.prototype.getTotalsMap = function() {
+	var __map = new Map();
+	var __obj = this.getTotals();
+	for (var __key in __obj) {
+		__map.set(Number(__key), __obj[__key]);
+	}
+	return __map;
+};
+
+/**
+ * @param {!Map.<number, number>} totals The totals.
+ */
+test.verify.Order.prototype.setTotalsMap = function(totals) {
+	var __obj = {};
+	totals.forEach(function(__value, __key) {
+		__obj[String(__key)] = __value;
+	});
+	this.setTotals(__obj);
+};
+
+/**
+ * @return {number}
+ */
+test.verify.Order.prototype.getSerial = function() {
+	var v = this.jsonData_["serial"];
+	return v != null ? v : 0;
+};
+
+/**
+ * @param {number} serial The serial.
+ */
+test.verify.Order.prototype.setSerial = function(serial) {
+	this.jsonData_["serial"] = serial;
+};
+
+/**
+ * @return {boolean} Whether the serial is set.
+ */
+test.verify.Order.prototype.hasSerial = function() {
+	return this.jsonData_["serial"] != null;
+};
+
+/**
+ * Clears the serial.
+ */
+test.verify.Order.prototype.clearSerial = function() {
+	delete this.jsonData_["serial"];
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.verify.Order.prototype.equals = function(other) {
+	if (!(other instanceof test.verify.Order)) {
+		return false;
+	}
+	if (!test.verify.messageEquals_(this.getItem(), other.getItem())) {
+		return false;
+	}
+	if (!test.verify.arrayEquals_(this.getItems(), other.getItems(), test.verify.messageEquals_)) {
+		return false;
+	}
+	if (!test.verify.objectEquals_(this.getByName(), other.getByName(), test.verify.messageEquals_)) {
+		return false;
+	}
+	if (!test.verify.arrayEquals_(this.getKinds(), other.getKinds(), null)) {
+		return false;
+	}
+	if (!test.verify.objectEquals_(this.getTotals(), other.getTotals(), null)) {
+		return false;
+	}
+	if (this.getSerial() !== other.getSerial()) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.verify.Order.prototype.deepCopy = function() {
+	return test.verify.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.verify.Order} A copy of the message, sharing no data with it.
+ */
+test.verify.Order.prototype.clone = function() {
+	return new test.verify.Order(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.verify.Order} other The other message.
+ */
+test.verify.Order.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["item"];
+	if (other.hasItem()) {
+		if (this.hasItem()) {
+			this.getItem().mergeFrom(other.getItem());
+		} else {
+			this.jsonData_["item"] = test.verify.copyJSON_(v);
+		}
+		this.item_ = undefined;
+	}
+	v = other.jsonData_["items"];
+	if (v != null && v.length) {
+		this.jsonData_["items"] = (this.jsonData_["items"] || []).concat(test.verify.copyJSON_(v));
+		this.items_ = undefined;
+	}
+	v = other.jsonData_["by_name"];
+	if (v != null) {
+		this.jsonData_["by_name"] = this.jsonData_["by_name"] || {};
+		for (var __key in v) {
+			this.jsonData_["by_name"][__key] = test.verify.copyJSON_(v[__key]);
+		}
+		this.by_name_ = undefined;
+	}
+	v = other.jsonData_["kinds"];
+	if (v != null && v.length) {
+		this.jsonData_["kinds"] = (this.jsonData_["kinds"] || []).concat(v);
+	}
+	v = other.jsonData_["totals"];
+	if (v != null) {
+		this.jsonData_["totals"] = this.jsonData_["totals"] || {};
+		for (var __key in v) {
+			this.jsonData_["totals"][__key] = test.verify.copyJSON_(v[__key]);
+		}
+	}
+	v = other.jsonData_["serial"];
+	if (other.hasSerial()) {
+		this.jsonData_["serial"] = v;
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.verify.Order.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.verify.fieldMaskPaths_(paths, "item");
+	if (p !== true) {
+		if (p.length && this.hasItem()) {
+			this.getItem().applyFieldMask(p);
+		} else {
+			delete this.jsonData_["item"];
+			this.item_ = undefined;
+		}
+	}
+	p = test.verify.fieldMaskPaths_(paths, "items");
+	if (p !== true) {
+		delete this.jsonData_["items"];
+		this.items_ = undefined;
+	}
+	p = test.verify.fieldMaskPaths_(paths, "by_name");
+	if (p !== true) {
+		delete this.jsonData_["by_name"];
+		this.by_name_ = undefined;
+	}
+	p = test.verify.fieldMaskPaths_(paths, "kinds");
+	if (p !== true) {
+		delete this.jsonData_["kinds"];
+	}
+	p = test.verify.fieldMaskPaths_(paths, "totals");
+	if (p !== true) {
+		delete this.jsonData_["totals"];
+		this.totals_ = undefined;
+	}
+	p = test.verify.fieldMaskPaths_(paths, "serial");
+	if (p !== true) {
+		delete this.jsonData_["serial"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.verify.Order} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.verify.Order.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasItem() && other.hasItem()) {
+		test.verify.appendPaths_(paths, "item.", this.getItem().diffFieldMask(other.getItem()));
+	} else if (this.hasItem() !== other.hasItem()) {
+		paths.push("item");
+	}
+	if (!test.verify.arrayEquals_(this.getItems(), other.getItems(), test.verify.messageEquals_)) {
+		paths.push("items");
+	}
+	if (!test.verify.objectEquals_(this.getByName(), other.getByName(), test.verify.messageEquals_)) {
+		paths.push("by_name");
+	}
+	if (!test.verify.arrayEquals_(this.getKinds(), other.getKinds(), null)) {
+		paths.push("kinds");
+	}
+	if (!test.verify.objectEquals_(this.getTotals(), other.getTotals(), null)) {
+		paths.push("totals");
+	}
+	if (this.hasSerial() !== other.hasSerial() || this.getSerial() !== other.getSerial()) {
+		paths.push("serial");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.verify.Order.prototype.validate = function() {
+	var violations = [];
+	var v;
+	if (this.hasItem()) {
+		v = this.getItem();
+		test.verify.nestedViolations_(violations, "item", v.validate());
+	}
+	v = this.getItems();
+	goog.array.forEach(v, function(__item, __index) {
+		test.verify.nestedViolations_(violations, "items[" + __index + "]", __item.validate());
+	}, this);
+	v = this.getByName();
+	for (var __key in v) {
+		test.verify.nestedViolations_(violations, "by_name[\"" + __key + "\"]", v[__key].validate());
+	}
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.verify.Order.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.verify.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.verify.verifyRequired_(errors, json["item"], prefix + "item");
+	test.verify.verifyValue_(errors, json["item"], prefix + "item", test.verify.Item.verify);
+	test.verify.verifyArray_(errors, json["items"], prefix + "items", test.verify.Item.verify);
+	test.verify.verifyMap_(errors, json["by_name"], prefix + "by_name", test.verify.Item.verify);
+	test.verify.verifyArray_(errors, json["kinds"], prefix + "kinds", test.verify.verifyEnum_(test.verify.KindUtil, false, true));
+	test.verify.verifyMap_(errors, json["totals"], prefix + "totals", test.verify.verifyInteger_(32, false, false));
+	test.verify.verifyValue_(errors, json["serial"], prefix + "serial", test.verify.verifyInteger_(64, true, false));
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.verify.Order.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.verify.Order.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.verify.Order} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.verify.Order.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["item"];
+	if (v != null) {
+		writer.writeMessage(1, new test.verify.Item(v), test.verify.Item.serializeBinaryToWriter);
+	}
+	v = message.jsonData_["items"];
+	if (v != null) {
+		goog.array.forEach(v, function(__item) {
+			writer.writeMessage(2, new test.verify.Item(__item), test.verify.Item.serializeBinaryToWriter);
+		}, this);
+	}
+	v = message.jsonData_["by_name"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(3, __key, function(__key, writer) {
+				writer.writeString(1, __key);
+				writer.writeMessage(2, new test.verify.Item(v[__key]), test.verify.Item.serializeBinaryToWriter);
+			});
+		}
+	}
+	v = message.jsonData_["kinds"];
+	if (v != null) {
+		writer.writeRepeatedEnum(4, v);
+	}
+	v = message.jsonData_["totals"];
+	if (v != null) {
+		for (var __key in v) {
+			writer.writeMessage(5, __key, function(__key, writer) {
+				writer.writeInt32(1, Number(__key));
+				writer.writeSint32(2, v[__key]);
+			});
+		}
+	}
+	v = message.jsonData_["serial"];
+	if (v != null) {
+		writer.writeFixed64(6, v);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.verify.Order} The message.
+ */
+test.verify.Order.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.verify.Order.deserializeBinaryFromReader(new test.verify.Order({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.verify.Order} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.verify.Order} The message.
+ */
+test.verify.Order.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			value = new test.verify.Item({});
+			reader.readMessage(value, test.verify.Item.deserializeBinaryFromReader);
+			message.jsonData_["item"] = value.getJsonData();
+			message.item_ = undefined;
+			break;
+		case 2:
+			value = new test.verify.Item({});
+			reader.readMessage(value, test.verify.Item.deserializeBinaryFromReader);
+			message.jsonData_["items"] = message.jsonData_["items"] || [];
+			message.jsonData_["items"].push(value.getJsonData());
+			break;
+		case 3:
+			value = {key: '', value: {}};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						__value = new test.verify.Item({});
+						reader.readMessage(__value, test.verify.Item.deserializeBinaryFromReader);
+						entry.value = __value.getJsonData();
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["by_name"] = message.jsonData_["by_name"] || {};
+			message.jsonData_["by_name"][String(value.key)] = value.value;
+			break;
+		case 4:
+			value = reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()];
+			message.jsonData_["kinds"] = (message.jsonData_["kinds"] || []).concat(value);
+			break;
+		case 5:
+			value = {key: 0, value: 0};
+			reader.readMessage(value, function(entry, reader) {
+				var __value;
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readInt32();
+						break;
+					case 2:
+						__value = reader.readSint32();
+						entry.value = __value;
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			message.jsonData_["totals"] = message.jsonData_["totals"] || {};
+			message.jsonData_["totals"][String(value.key)] = value.value;
+			break;
+		case 6:
+			value = reader.readFixed64();
+			message.jsonData_["serial"] = value;
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Appends the paths of the fields of a message field to the paths of a field
+ * mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} prefix The name of the field followed by a dot.
+ * @param {!Array.<string>} sub The paths relative to the field.
+ */
+test.verify.appendPaths_ = function(paths, prefix, sub) {
+	for (var i = 0; i < sub.length; i++) {
+		paths.push(prefix + sub[i]);
+	}
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.verify.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.verify.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.verify.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.verify.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.verify.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two messages, or unset messages.
+ * @param {?{equals: function(*): boolean}|undefined} a The first message.
+ * @param {?{equals: function(*): boolean}|undefined} b The second message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.verify.messageEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.equals(b);
+};
+
+/**
+ * Appends the violations of the rules of a message field to the violations
+ * of the message.
+ * @param {!Array.<{field: string, rule: string, message: string}>} violations
+ *     The violations of the message.
+ * @param {string} path The path of the field.
+ * @param {!Array.<{field: string, rule: string, message: string}>} nested The
+ *     violations of the message of the field.
+ */
+test.verify.nestedViolations_ = function(violations, path, nested) {
+	for (var i = 0; i < nested.length; i++) {
+		violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+	}
+};
+
+/**
+ * Compares the values of two maps, as objects, key by key.
+ * @param {!Object} a The first map.
+ * @param {!Object} b The second map.
+ * @param {?function(*, *): boolean} eq The comparison of the values, or null to
+ *     compare them with ===.
+ * @return {boolean} Whether the maps are equal.
+ */
+test.verify.objectEquals_ = function(a, b, eq) {
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		var key = keys[i];
+		if (!Object.prototype.hasOwnProperty.call(b, key) || (eq ? !eq(a[key], b[key]) : a[key] !== b[key])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.verify.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.verify.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.verify.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.verify.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Returns the check of the JSON values of an enum.
+ * @param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+ *     util The helpers of the enum.
+ * @param {boolean} names Whether the values may be the names of the enum values
+ *     and the numbers decimal strings, as in the canonical JSON mapping.
+ * @param {boolean} closed Whether the numbers must be values of the enum.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.verify.verifyEnum_ = function(util, names, closed) {
+	return function(v, path) {
+		if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+			return util.valueOf(v) !== null ? [] :
+					[test.verify.verifyError_(path, 'a name of a value of the enum', v)];
+		}
+		if (names && typeof v === 'string') {
+			v = Number(v);
+		}
+		if (typeof v !== 'number' || v % 1 !== 0) {
+			return [test.verify.verifyError_(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+		}
+		return !closed || util.isValid(v) ? [] : [test.verify.verifyError_(path, 'a value of the enum', v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.verify.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.verify.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.verify.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.verify.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks the JSON object of a map field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The object.
+ * @param {string} path The path of the object.
+ * @param {?function(*, string): !Array.<string>} check The check of the values,
+ *     or null to accept any value.
+ */
+test.verify.verifyMap_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (typeof v !== 'object' || Array.isArray(v)) {
+		errors.push(test.verify.verifyError_(path, 'an object', v));
+		return;
+	}
+	for (var key in v) {
+		if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+			Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+		}
+	}
+};
+
+/**
+ * Checks that the JSON value of a required field is set.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ */
+test.verify.verifyRequired_ = function(errors, v, path) {
+	if (v == null) {
+		errors.push(path + ': missing required field');
+	}
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.verify.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
@@ -0,0 +1,1455 @@
+// Code generated by protoc-gen-js.
+// source: test/wkt.proto
+// DO NOT EDIT!
+
+/**
+ * @fileoverview Generated protocol buffers in Javascript.
+ */
+
+goog.provide('test.wkt.Record');
+goog.provide('test.wkt.registerAnyTypes');
+
+goog.require('goog.array');
+goog.require('goog.crypt.base64');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.wkt.Record = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.wkt.Record.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.wkt.Record.TYPE_NAME = 'test.wkt.Record';
+
+/**
+ * @return {?Date}
+ */
+test.wkt.Record.prototype.getCreated = function() {
+	var v = this.jsonData_["created"];
+	return v != null ? test.wkt.timestampFromJSON_(v) : null;
+};
+
+/**
+ * @param {?Date} created The created.
+ */
+test.wkt.Record.prototype.setCreated = function(created) {
+	this.jsonData_["created"] = (created == null ? null : created.toISOString());
+};
+
+/**
+ * @return {boolean} Whether the created is set.
+ */
+test.wkt.Record.prototype.hasCreated = function() {
+	return this.jsonData_["created"] != null;
+};
+
+/**
+ * Clears the created.
+ */
+test.wkt.Record.prototype.clearCreated = function() {
+	delete this.jsonData_["created"];
+};
+
+/**
+ * @return {?number}
+ */
+test.wkt.Record.prototype.getTimeout = function() {
+	var v = this.jsonData_["timeout"];
+	return v != null ? test.wkt.durationFromJSON_(v) : null;
+};
+
+/**
+ * @param {?number} timeout The timeout.
+ */
+test.wkt.Record.prototype.setTimeout = function(timeout) {
+	this.jsonData_["timeout"] = (timeout == null ? null : test.wkt.durationToJSON_(timeout));
+};
+
+/**
+ * @return {boolean} Whether the timeout is set.
+ */
+test.wkt.Record.prototype.hasTimeout = function() {
+	return this.jsonData_["timeout"] != null;
+};
+
+/**
+ * Clears the timeout.
+ */
+test.wkt.Record.prototype.clearTimeout = function() {
+	delete this.jsonData_["timeout"];
+};
+
+/**
+ * @return {?number}
+ */
+test.wkt.Record.prototype.getCount = function() {
+	var v = this.jsonData_["count"];
+	return v != null ? Number(v) : null;
+};
+
+/**
+ * @param {?number} count The count.
+ */
+test.wkt.Record.prototype.setCount = function(count) {
+	this.jsonData_["count"] = count;
+};
+
+/**
+ * @return {boolean} Whether the count is set.
+ */
+test.wkt.Record.prototype.hasCount = function() {
+	return this.jsonData_["count"] != null;
+};
+
+/**
+ * Clears the count.
+ */
+test.wkt.Record.prototype.clearCount = function() {
+	delete this.jsonData_["count"];
+};
+
+/**
+ * @return {?number}
+ */
+test.wkt.Record.prototype.getTotal = function() {
+	var v = this.jsonData_["total"];
+	return v != null ? Number(v) : null;
+};
+
+/**
+ * @param {?number} total The total.
+ */
+test.wkt.Record.prototype.setTotal = function(total) {
+	this.jsonData_["total"] = (total == null ? null : String(total));
+};
+
+/**
+ * @return {boolean} Whether the total is set.
+ */
+test.wkt.Record.prototype.hasTotal = function() {
+	return this.jsonData_["total"] != null;
+};
+
+/**
+ * Clears the total.
+ */
+test.wkt.Record.prototype.clearTotal = function() {
+	delete this.jsonData_["total"];
+};
+
+/**
+ * @return {?string}
+ */
+test.wkt.Record.prototype.getBlob = function() {
+	var v = this.jsonData_["blob"];
+	return v != null ? v : null;
+};
+
+/**
+ * @param {?string} blob The blob.
+ */
+test.wkt.Record.prototype.setBlob = function(blob) {
+	this.jsonData_["blob"] = blob;
+};
+
+/**
+ * @return {boolean} Whether the blob is set.
+ */
+test.wkt.Record.prototype.hasBlob = function() {
+	return this.jsonData_["blob"] != null;
+};
+
+/**
+ * Clears the blob.
+ */
+test.wkt.Record.prototype.clearBlob = function() {
+	delete this.jsonData_["blob"];
+};
+
+/**
+ * @return {?Object.<string, *>}
+ */
+test.wkt.Record.prototype.getLabels = function() {
+	var v = this.jsonData_["labels"];
+	return v != null ? v : null;
+};
+
+/**
+ * @param {?Object.<string, *>} labels The labels.
+ */
+test.wkt.Record.prototype.setLabels = function(labels) {
+	this.jsonData_["labels"] = labels;
+};
+
+/**
+ * @return {boolean} Whether the labels is set.
+ */
+test.wkt.Record.prototype.hasLabels = function() {
+	return this.jsonData_["labels"] != null;
+};
+
+/**
+ * Clears the labels.
+ */
+test.wkt.Record.prototype.clearLabels = function() {
+	delete this.jsonData_["labels"];
+};
+
+/**
+ * @return {*}
+ */
+test.wkt.Record.prototype.getExtra = function() {
+	var v = this.jsonData_["extra"];
+	return v != null ? v : null;
+};
+
+/**
+ * @param {*} extra The extra.
+ */
+test.wkt.Record.prototype.setExtra = function(extra) {
+	this.jsonData_["extra"] = extra;
+};
+
+/**
+ * @return {boolean} Whether the extra is set.
+ */
+test.wkt.Record.prototype.hasExtra = function() {
+	return this.jsonData_["extra"] != null;
+};
+
+/**
+ * Clears the extra.
+ */
+test.wkt.Record.prototype.clearExtra = function() {
+	delete this.jsonData_["extra"];
+};
+
+/**
+ * @return {?Array.<*>}
+ */
+test.wkt.Record.prototype.getItems = function() {
+	var v = this.jsonData_["items"];
+	return v != null ? v : null;
+};
+
+/**
+ * @param {?Array.<*>} items The items.
+ */
+test.wkt.Record.prototype.setItems = function(items) {
+	this.jsonData_["items"] = items;
+};
+
+/**
+ * @return {boolean} Whether the items is set.
+ */
+test.wkt.Record.prototype.hasItems = function() {
+	return this.jsonData_["items"] != null;
+};
+
+/**
+ * Clears the items.
+ */
+test.wkt.Record.prototype.clearItems = function() {
+	delete this.jsonData_["items"];
+};
+
+/**
+ * @return {?Array.<string>}
+ */
+test.wkt.Record.prototype.getMask = function() {
+	var v = this.jsonData_["mask"];
+	return v != null ? test.wkt.fieldMaskFromJSON_(v) : null;
+};
+
+/**
+ * @param {?Array.<string>} mask The mask.
+ */
+test.wkt.Record.prototype.setMask = function(mask) {
+	this.jsonData_["mask"] = (mask == null ? null : test.wkt.fieldMaskToJSON_(mask));
+};
+
+/**
+ * @return {boolean} Whether the mask is set.
+ */
+test.wkt.Record.prototype.hasMask = function() {
+	return this.jsonData_["mask"] != null;
+};
+
+/**
+ * Clears the mask.
+ */
+test.wkt.Record.prototype.clearMask = function() {
+	delete this.jsonData_["mask"];
+};
+
+/**
+ * @return {?Object}
+ */
+test.wkt.Record.prototype.getPayload = function() {
+	var v = this.jsonData_["payload"];
+	return v != null ? v : null;
+};
+
+/**
+ * @param {?Object} payload The payload.
+ */
+test.wkt.Record.prototype.setPayload = function(payload) {
+	this.jsonData_["payload"] = payload;
+};
+
+/**
+ * @return {boolean} Whether the payload is set.
+ */
+test.wkt.Record.prototype.hasPayload = function() {
+	return this.jsonData_["payload"] != null;
+};
+
+/**
+ * Clears the payload.
+ */
+test.wkt.Record.prototype.clearPayload = function() {
+	delete this.jsonData_["payload"];
+};
+
+/**
+ * Packs the message into the payload.
+ * @param {!Object} message The message, of a generated class.
+ */
+test.wkt.Record.prototype.packPayload = function(message) {
+	var __data = {'@type': 'type.googleapis.com/' + message.constructor.TYPE_NAME};
+	var __json = message.getJsonData();
+	for (var __key in __json) {
+		__data[__key] = __json[__key];
+	}
+	this.setPayload(__data);
+};
+
+/**
+ * @return {Object} The message packed in the payload, or null if it's unset or
+ *     its type isn't registered.
+ */
+test.wkt.Record.prototype.unpackPayload = function() {
+	var v = this.getPayload();
+	var __type = v && test.wkt.anyType_(v['@type'] || '');
+	if (!__type) {
+		return null;
+	}
+	var __data = {};
+	for (var __key in v) {
+		if (__key !== '@type') {
+			__data[__key] = v[__key];
+		}
+	}
+	return new __type(__data);
+};
+
+/**
+ * @return {Array.<Date>}
+ */
+test.wkt.Record.prototype.getHistory = function() {
+	return (this.jsonData_["history"] || []).map(function(__v) {
+		return test.wkt.timestampFromJSON_(__v);
+	});
+};
+
+/**
+ * @param {Array.<Date>} history The history.
+ */
+test.wkt.Record.prototype.setHistory = function(history) {
+	this.jsonData_["history"] = history.map(function(__v) {
+		return (__v == null ? null : __v.toISOString());
+	});
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.wkt.Record.prototype.equals = function(other) {
+	if (!(other instanceof test.wkt.Record)) {
+		return false;
+	}
+	if (!test.wkt.dateEquals_(this.getCreated(), other.getCreated())) {
+		return false;
+	}
+	if (this.getTimeout() !== other.getTimeout()) {
+		return false;
+	}
+	if (this.getCount() !== other.getCount()) {
+		return false;
+	}
+	if (this.getTotal() !== other.getTotal()) {
+		return false;
+	}
+	if (this.getBlob() !== other.getBlob()) {
+		return false;
+	}
+	if (!test.wkt.jsonEquals_(this.getLabels(), other.getLabels())) {
+		return false;
+	}
+	if (!test.wkt.jsonEquals_(this.getExtra(), other.getExtra())) {
+		return false;
+	}
+	if (!test.wkt.jsonEquals_(this.getItems(), other.getItems())) {
+		return false;
+	}
+	if (!test.wkt.jsonEquals_(this.getMask(), other.getMask())) {
+		return false;
+	}
+	if (!test.wkt.jsonEquals_(this.getPayload(), other.getPayload())) {
+		return false;
+	}
+	if (!test.wkt.arrayEquals_(this.getHistory(), other.getHistory(), test.wkt.dateEquals_)) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.wkt.Record.prototype.deepCopy = function() {
+	return test.wkt.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.wkt.Record} A copy of the message, sharing no data with it.
+ */
+test.wkt.Record.prototype.clone = function() {
+	return new test.wkt.Record(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.wkt.Record} other The other message.
+ */
+test.wkt.Record.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["created"];
+	if (other.hasCreated()) {
+		this.jsonData_["created"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["timeout"];
+	if (other.hasTimeout()) {
+		this.jsonData_["timeout"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["count"];
+	if (other.hasCount()) {
+		this.jsonData_["count"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["total"];
+	if (other.hasTotal()) {
+		this.jsonData_["total"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["blob"];
+	if (other.hasBlob()) {
+		this.jsonData_["blob"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["labels"];
+	if (other.hasLabels()) {
+		if (this.hasLabels()) {
+			Object.assign(this.jsonData_["labels"], test.wkt.copyJSON_(v));
+		} else {
+			this.jsonData_["labels"] = test.wkt.copyJSON_(v);
+		}
+	}
+	v = other.jsonData_["extra"];
+	if (other.hasExtra()) {
+		this.jsonData_["extra"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["items"];
+	if (other.hasItems()) {
+		if (this.hasItems()) {
+			this.jsonData_["items"] = this.jsonData_["items"].concat(test.wkt.copyJSON_(v));
+		} else {
+			this.jsonData_["items"] = test.wkt.copyJSON_(v);
+		}
+	}
+	v = other.jsonData_["mask"];
+	if (other.hasMask()) {
+		if (this.hasMask()) {
+			this.jsonData_["mask"] = test.wkt.fieldMaskToJSON_(test.wkt.fieldMaskFromJSON_(this.jsonData_["mask"]).concat(test.wkt.fieldMaskFromJSON_(v)));
+		} else {
+			this.jsonData_["mask"] = test.wkt.copyJSON_(v);
+		}
+	}
+	v = other.jsonData_["payload"];
+	if (other.hasPayload()) {
+		this.jsonData_["payload"] = test.wkt.copyJSON_(v);
+	}
+	v = other.jsonData_["history"];
+	if (v != null && v.length) {
+		this.jsonData_["history"] = (this.jsonData_["history"] || []).concat(test.wkt.copyJSON_(v));
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.wkt.Record.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.wkt.fieldMaskPaths_(paths, "created");
+	if (p !== true) {
+		delete this.jsonData_["created"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "timeout");
+	if (p !== true) {
+		delete this.jsonData_["timeout"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "count");
+	if (p !== true) {
+		delete this.jsonData_["count"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "total");
+	if (p !== true) {
+		delete this.jsonData_["total"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "blob");
+	if (p !== true) {
+		delete this.jsonData_["blob"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "labels");
+	if (p !== true) {
+		delete this.jsonData_["labels"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "extra");
+	if (p !== true) {
+		delete this.jsonData_["extra"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "items");
+	if (p !== true) {
+		delete this.jsonData_["items"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "mask");
+	if (p !== true) {
+		delete this.jsonData_["mask"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "payload");
+	if (p !== true) {
+		delete this.jsonData_["payload"];
+	}
+	p = test.wkt.fieldMaskPaths_(paths, "history");
+	if (p !== true) {
+		delete this.jsonData_["history"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.wkt.Record} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.wkt.Record.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasCreated() !== other.hasCreated() || !test.wkt.dateEquals_(this.getCreated(), other.getCreated())) {
+		paths.push("created");
+	}
+	if (this.hasTimeout() !== other.hasTimeout() || this.getTimeout() !== other.getTimeout()) {
+		paths.push("timeout");
+	}
+	if (this.hasCount() !== other.hasCount() || this.getCount() !== other.getCount()) {
+		paths.push("count");
+	}
+	if (this.hasTotal() !== other.hasTotal() || this.getTotal() !== other.getTotal()) {
+		paths.push("total");
+	}
+	if (this.hasBlob() !== other.hasBlob() || this.getBlob() !== other.getBlob()) {
+		paths.push("blob");
+	}
+	if (this.hasLabels() !== other.hasLabels() || !test.wkt.jsonEquals_(this.getLabels(), other.getLabels())) {
+		paths.push("labels");
+	}
+	if (this.hasExtra() !== other.hasExtra() || !test.wkt.jsonEquals_(this.getExtra(), other.getExtra())) {
+		paths.push("extra");
+	}
+	if (this.hasItems() !== other.hasItems() || !test.wkt.jsonEquals_(this.getItems(), other.getItems())) {
+		paths.push("items");
+	}
+	if (this.hasMask() !== other.hasMask() || !test.wkt.jsonEquals_(this.getMask(), other.getMask())) {
+		paths.push("mask");
+	}
+	if (this.hasPayload() !== other.hasPayload() || !test.wkt.jsonEquals_(this.getPayload(), other.getPayload())) {
+		paths.push("payload");
+	}
+	if (!test.wkt.arrayEquals_(this.getHistory(), other.getHistory(), test.wkt.dateEquals_)) {
+		paths.push("history");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.wkt.Record.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.wkt.Record.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.wkt.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.wkt.verifyValue_(errors, json["created"], prefix + "created", test.wkt.verifyString_);
+	test.wkt.verifyValue_(errors, json["timeout"], prefix + "timeout", test.wkt.verifyString_);
+	test.wkt.verifyValue_(errors, json["count"], prefix + "count", test.wkt.verifyInteger_(32, false, true));
+	test.wkt.verifyValue_(errors, json["total"], prefix + "total", test.wkt.verifyInteger_(64, false, true));
+	test.wkt.verifyValue_(errors, json["blob"], prefix + "blob", test.wkt.verifyString_);
+	test.wkt.verifyValue_(errors, json["labels"], prefix + "labels", test.wkt.verifyObject_);
+	test.wkt.verifyValue_(errors, json["items"], prefix + "items", test.wkt.verifyList_);
+	test.wkt.verifyValue_(errors, json["mask"], prefix + "mask", test.wkt.verifyString_);
+	test.wkt.verifyValue_(errors, json["payload"], prefix + "payload", test.wkt.verifyObject_);
+	test.wkt.verifyArray_(errors, json["history"], prefix + "history", test.wkt.verifyString_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.wkt.Record.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.wkt.Record.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.wkt.Record} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.Record.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["created"];
+	if (v != null) {
+		writer.writeMessage(1, v, test.wkt.writeTimestamp_);
+	}
+	v = message.jsonData_["timeout"];
+	if (v != null) {
+		writer.writeMessage(2, v, test.wkt.writeDuration_);
+	}
+	v = message.jsonData_["count"];
+	if (v != null) {
+		writer.writeMessage(3, v, function(__v, writer) {
+			writer.writeInt32(1, Number(__v));
+		});
+	}
+	v = message.jsonData_["total"];
+	if (v != null) {
+		writer.writeMessage(4, v, function(__v, writer) {
+			writer.writeInt64(1, Number(__v));
+		});
+	}
+	v = message.jsonData_["blob"];
+	if (v != null) {
+		writer.writeMessage(5, v, function(__v, writer) {
+			writer.writeBytes(1, __v);
+		});
+	}
+	v = message.jsonData_["labels"];
+	if (v != null) {
+		writer.writeMessage(6, v, test.wkt.writeStruct_);
+	}
+	v = message.jsonData_["extra"];
+	if (v != null) {
+		writer.writeMessage(7, [v], test.wkt.writeValue_);
+	}
+	v = message.jsonData_["items"];
+	if (v != null) {
+		writer.writeMessage(8, v, test.wkt.writeListValue_);
+	}
+	v = message.jsonData_["mask"];
+	if (v != null) {
+		writer.writeMessage(9, v, test.wkt.writeFieldMask_);
+	}
+	v = message.jsonData_["payload"];
+	if (v != null) {
+		writer.writeMessage(10, v, test.wkt.writeAny_);
+	}
+	v = message.jsonData_["history"];
+	if (v != null) {
+		goog.array.forEach(v, function(__item) {
+			writer.writeMessage(11, __item, test.wkt.writeTimestamp_);
+		}, this);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.wkt.Record} The message.
+ */
+test.wkt.Record.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.wkt.Record.deserializeBinaryFromReader(new test.wkt.Record({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.wkt.Record} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.wkt.Record} The message.
+ */
+test.wkt.Record.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			message.jsonData_["created"] = test.wkt.readTimestamp_(reader);
+			break;
+		case 2:
+			message.jsonData_["timeout"] = test.wkt.readDuration_(reader);
+			break;
+		case 3:
+			message.jsonData_["count"] = test.wkt.readWrapper_(reader, function(reader) { return reader.readInt32(); }, 0);
+			break;
+		case 4:
+			message.jsonData_["total"] = test.wkt.readWrapper_(reader, function(reader) { return String(reader.readInt64()); }, 0);
+			break;
+		case 5:
+			message.jsonData_["blob"] = test.wkt.readWrapper_(reader, function(reader) { return goog.crypt.base64.encodeByteArray(reader.readBytes()); }, '');
+			break;
+		case 6:
+			message.jsonData_["labels"] = test.wkt.readStruct_(reader);
+			break;
+		case 7:
+			message.jsonData_["extra"] = test.wkt.readValue_(reader);
+			break;
+		case 8:
+			message.jsonData_["items"] = test.wkt.readListValue_(reader);
+			break;
+		case 9:
+			message.jsonData_["mask"] = test.wkt.readFieldMask_(reader);
+			break;
+		case 10:
+			message.jsonData_["payload"] = test.wkt.readAny_(reader);
+			break;
+		case 11:
+			value = test.wkt.readTimestamp_(reader);
+			message.jsonData_["history"] = message.jsonData_["history"] || [];
+			message.jsonData_["history"].push(value);
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Looks up the registered class of the message type of a type URL.
+ * @param {string} url The type URL of a google.protobuf.Any.
+ * @return {?Function} The class, or null if it isn't registered.
+ */
+test.wkt.anyType_ = function(url) {
+	var name = url.substring(url.lastIndexOf('/') + 1);
+	return Object.prototype.hasOwnProperty.call(goog.global['jspbAnyTypes'], name) ? goog.global['jspbAnyTypes'][name] : null;
+};
+
+/**
+ * Compares two arrays element by element.
+ * @param {!Array} a The first array.
+ * @param {!Array} b The second array.
+ * @param {?function(*, *): boolean} eq The comparison of the elements, or null
+ *     to compare them with ===.
+ * @return {boolean} Whether the arrays are equal.
+ */
+test.wkt.arrayEquals_ = function(a, b, eq) {
+	if (a.length !== b.length) {
+		return false;
+	}
+	for (var i = 0; i < a.length; i++) {
+		if (eq ? !eq(a[i], b[i]) : a[i] !== b[i]) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.wkt.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.wkt.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.wkt.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Compares two dates, or nulls.
+ * @param {?Date} a The first date.
+ * @param {?Date} b The second date.
+ * @return {boolean} Whether the dates are equal.
+ */
+test.wkt.dateEquals_ = function(a, b) {
+	return a == null ? b == null : b != null && a.getTime() === b.getTime();
+};
+
+/**
+ * Converts a google.protobuf.Duration from its JSON form into milliseconds.
+ * @param {*} v The string of seconds with the "s" suffix, or the object with
+ *     seconds and nanos.
+ * @return {number} The milliseconds.
+ */
+test.wkt.durationFromJSON_ = function(v) {
+	if (typeof v === 'string') {
+		return parseFloat(v) * 1000;
+	}
+	return Number(v.seconds || 0) * 1000 + (v.nanos || 0) / 1e6;
+};
+
+/**
+ * Converts milliseconds into the JSON form of a google.protobuf.Duration.
+ * @param {number} ms The milliseconds.
+ * @return {string} The string of seconds with the "s" suffix.
+ */
+test.wkt.durationToJSON_ = function(ms) {
+	return (ms / 1000).toFixed(9).replace(/\.?(000)+$/, '') + 's';
+};
+
+/**
+ * Converts a google.protobuf.FieldMask from its JSON form into its paths.
+ * @param {*} v The comma-separated lowerCamelCase paths, or the object with
+ *     the paths.
+ * @return {!Array.<string>} The paths, with the field names of the .proto file.
+ */
+test.wkt.fieldMaskFromJSON_ = function(v) {
+	var paths = typeof v === 'string' ? (v ? v.split(',') : []) : (v.paths || []);
+	return paths.map(function(path) {
+		return path.replace(/[A-Z]/g, function(c) {
+			return '_' + c.toLowerCase();
+		});
+	});
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.wkt.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Converts the paths of a google.protobuf.FieldMask into its JSON form.
+ * @param {!Array.<string>} paths The paths, with the field names of the .proto
+ *     file.
+ * @return {string} The comma-separated lowerCamelCase paths.
+ */
+test.wkt.fieldMaskToJSON_ = function(paths) {
+	return paths.map(function(path) {
+		return path.replace(/_([a-z])/g, function(m, c) {
+			return c.toUpperCase();
+		});
+	}).join(',');
+};
+
+/**
+ * Compares two JSON values deeply, NaN being equal to NaN.
+ * @param {*} a The first value.
+ * @param {*} b The second value.
+ * @return {boolean} Whether the values are equal.
+ */
+test.wkt.jsonEquals_ = function(a, b) {
+	if (a === b || (a !== a && b !== b)) {
+		return true;
+	}
+	if (a === null || b === null || typeof a !== 'object' || typeof b !== 'object' ||
+			Array.isArray(a) !== Array.isArray(b)) {
+		return false;
+	}
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		if (!Object.prototype.hasOwnProperty.call(b, keys[i]) || !test.wkt.jsonEquals_(a[keys[i]], b[keys[i]])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Reads a google.protobuf.Any into its JSON form. The packed message is
+ * deserialized by its registered class; the value of an unregistered type is
+ * kept as a base64 string in "value".
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Object} The JSON object with the type URL in "@type".
+ */
+test.wkt.readAny_ = function(reader) {
+	var any = {url: '', bytes: null};
+	reader.readMessage(any, function(any, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			switch (reader.getFieldNumber()) {
+			case 1:
+				any.url = reader.readString();
+				break;
+			case 2:
+				any.bytes = reader.readBytes();
+				break;
+			default:
+				reader.skipField();
+			}
+		}
+	});
+	var v = {'@type': any.url};
+	var type = test.wkt.anyType_(any.url);
+	if (type && any.bytes) {
+		var data = type.deserializeBinary(any.bytes).getJsonData();
+		for (var key in data) {
+			v[key] = data[key];
+		}
+	} else if (any.bytes) {
+		v['value'] = goog.crypt.base64.encodeByteArray(any.bytes);
+	}
+	return v;
+};
+
+/**
+ * Reads a google.protobuf.Duration into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {string} The string of seconds with the "s" suffix.
+ */
+test.wkt.readDuration_ = function(reader) {
+	var t = test.wkt.readSecondsAndNanos_(reader);
+	var fraction = t[1] ? '.' + String(1e9 + Math.abs(t[1])).substring(1).replace(/(000)+$/, '') : '';
+	return (t[0] < 0 || t[1] < 0 ? '-' : '') + Math.abs(t[0]) + fraction + 's';
+};
+
+/**
+ * Reads a google.protobuf.FieldMask into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {string} The comma-separated lowerCamelCase paths.
+ */
+test.wkt.readFieldMask_ = function(reader) {
+	var paths = [];
+	reader.readMessage(paths, function(paths, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			if (reader.getFieldNumber() === 1) {
+				paths.push(reader.readString());
+			} else {
+				reader.skipField();
+			}
+		}
+	});
+	return test.wkt.fieldMaskToJSON_(paths);
+};
+
+/**
+ * Reads a google.protobuf.ListValue into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Array.<*>} The array.
+ */
+test.wkt.readListValue_ = function(reader) {
+	var list = [];
+	reader.readMessage(list, function(list, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			if (reader.getFieldNumber() === 1) {
+				list.push(test.wkt.readValue_(reader));
+			} else {
+				reader.skipField();
+			}
+		}
+	});
+	return list;
+};
+
+/**
+ * Reads a google.protobuf.Timestamp or a google.protobuf.Duration.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Array.<number>} The seconds and the nanos.
+ */
+test.wkt.readSecondsAndNanos_ = function(reader) {
+	var t = [0, 0];
+	reader.readMessage(t, function(t, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			switch (reader.getFieldNumber()) {
+			case 1:
+				t[0] = reader.readInt64();
+				break;
+			case 2:
+				t[1] = reader.readInt32();
+				break;
+			default:
+				reader.skipField();
+			}
+		}
+	});
+	return t;
+};
+
+/**
+ * Reads a google.protobuf.Struct into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Object.<string, *>} The object.
+ */
+test.wkt.readStruct_ = function(reader) {
+	var struct = {};
+	reader.readMessage(struct, function(struct, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			if (reader.getFieldNumber() !== 1) {
+				reader.skipField();
+				continue;
+			}
+			var entry = {key: '', value: null};
+			reader.readMessage(entry, function(entry, reader) {
+				while (reader.nextField()) {
+					if (reader.isEndGroup()) {
+						break;
+					}
+					switch (reader.getFieldNumber()) {
+					case 1:
+						entry.key = reader.readString();
+						break;
+					case 2:
+						entry.value = test.wkt.readValue_(reader);
+						break;
+					default:
+						reader.skipField();
+					}
+				}
+			});
+			struct[entry.key] = entry.value;
+		}
+	});
+	return struct;
+};
+
+/**
+ * Reads a google.protobuf.Timestamp into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {string} The RFC 3339 string.
+ */
+test.wkt.readTimestamp_ = function(reader) {
+	var t = test.wkt.readSecondsAndNanos_(reader);
+	var s = new Date(t[0] * 1000).toISOString().replace(/\.\d+Z$/, '');
+	return s + (t[1] ? '.' + String(1e9 + t[1]).substring(1).replace(/(000)+$/, '') : '') + 'Z';
+};
+
+/**
+ * Reads a google.protobuf.Value into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {*} The JSON value.
+ */
+test.wkt.readValue_ = function(reader) {
+	var value = [null];
+	reader.readMessage(value, function(value, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			switch (reader.getFieldNumber()) {
+			case 1:
+				reader.readEnum();
+				value[0] = null;
+				break;
+			case 2:
+				value[0] = reader.readDouble();
+				break;
+			case 3:
+				value[0] = reader.readString();
+				break;
+			case 4:
+				value[0] = reader.readBool();
+				break;
+			case 5:
+				value[0] = test.wkt.readStruct_(reader);
+				break;
+			case 6:
+				value[0] = test.wkt.readListValue_(reader);
+				break;
+			default:
+				reader.skipField();
+			}
+		}
+	});
+	return value[0];
+};
+
+/**
+ * Reads a wrapper, like google.protobuf.Int32Value, into its JSON form.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @param {function(!jspb.BinaryReader): *} read Reads the JSON form of the
+ *     value field.
+ * @param {*} zero The JSON form of the zero value.
+ * @return {*} The JSON form of the value.
+ */
+test.wkt.readWrapper_ = function(reader, read, zero) {
+	var wrapper = {value: zero};
+	reader.readMessage(wrapper, function(wrapper, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			if (reader.getFieldNumber() === 1) {
+				wrapper.value = read(reader);
+			} else {
+				reader.skipField();
+			}
+		}
+	});
+	return wrapper.value;
+};
+
+/**
+ * Converts a google.protobuf.Timestamp from its JSON form into a Date.
+ * @param {*} v The RFC 3339 string, or the object with seconds and nanos.
+ * @return {!Date} The date, truncated to milliseconds.
+ */
+test.wkt.timestampFromJSON_ = function(v) {
+	if (typeof v === 'string') {
+		// Date parses at most three fractional digits.
+		return new Date(v.replace(/(\.\d{3})\d+/, '$1'));
+	}
+	return new Date(Number(v.seconds || 0) * 1000 + (v.nanos || 0) / 1e6);
+};
+
+/**
+ * Checks the JSON array of a repeated field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The array.
+ * @param {string} path The path of the array.
+ * @param {?function(*, string): !Array.<string>} check The check of the
+ *     elements, or null to accept any value.
+ */
+test.wkt.verifyArray_ = function(errors, v, path, check) {
+	if (v == null) {
+		return;
+	}
+	if (!Array.isArray(v)) {
+		errors.push(test.wkt.verifyError_(path, 'an array', v));
+		return;
+	}
+	for (var i = 0; check && i < v.length; i++) {
+		Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+	}
+};
+
+/**
+ * Returns the check of the JSON values of a 64-bit integer type kept as
+ * decimal strings.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.wkt.verifyDecimal_ = function(unsigned) {
+	var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+	return function(v, path) {
+		var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+		// The largest magnitude of the sign, compared as a string of the same length.
+		var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+				m && m[1] ? '9223372036854775808' : '9223372036854775807';
+		return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+				[test.wkt.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.wkt.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Returns the check of the JSON values of an integer type.
+ * @param {number} bits The size of the integers, 32 or 64 bits.
+ * @param {boolean} unsigned Whether the integers are unsigned.
+ * @param {boolean} quoted Whether the integers may be decimal strings, as in
+ *     the canonical JSON mapping.
+ * @return {function(*, string): !Array.<string>} The check.
+ */
+test.wkt.verifyInteger_ = function(bits, unsigned, quoted) {
+	var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+	var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+	var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+	return function(v, path) {
+		if (quoted && bits === 64 && typeof v === 'string') {
+			// Beyond 2^53, the numbers aren't exact.
+			return test.wkt.verifyDecimal_(unsigned)(v, path);
+		}
+		var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+		return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+				[test.wkt.verifyError_(path, expected, v)];
+	};
+};
+
+/**
+ * Checks that a JSON value is an array.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.wkt.verifyList_ = function(v, path) {
+	return Array.isArray(v) ? [] : [test.wkt.verifyError_(path, 'an array', v)];
+};
+
+/**
+ * Checks that a JSON value is an object.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.wkt.verifyObject_ = function(v, path) {
+	return typeof v === 'object' && !Array.isArray(v) ? [] : [test.wkt.verifyError_(path, 'an object', v)];
+};
+
+/**
+ * Checks that a JSON value is a string.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.wkt.verifyString_ = function(v, path) {
+	return typeof v === 'string' ? [] : [test.wkt.verifyError_(path, 'a string', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.wkt.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
+/**
+ * Writes a google.protobuf.Any in its JSON form. The packed message is
+ * serialized by its registered class; the value of an unregistered type is
+ * written as is.
+ * @param {!Object} v The JSON object with the type URL in "@type".
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeAny_ = function(v, writer) {
+	var url = v['@type'] || '';
+	var type = test.wkt.anyType_(url);
+	writer.writeString(1, url);
+	if (type) {
+		var data = {};
+		for (var key in v) {
+			if (key !== '@type') {
+				data[key] = v[key];
+			}
+		}
+		writer.writeBytes(2, new type(data).serializeBinary());
+	} else if (v['value'] != null) {
+		writer.writeBytes(2, v['value']);
+	}
+};
+
+/**
+ * Writes a google.protobuf.Duration in its JSON form.
+ * @param {*} v The duration.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeDuration_ = function(v, writer) {
+	var seconds = Number(v.seconds || 0), nanos = v.nanos || 0;
+	if (typeof v === 'string') {
+		var m = /^(-?)(\d*)(?:\.(\d+))?s$/.exec(v) || ['', '', '', ''];
+		seconds = Number(m[2] || 0);
+		nanos = m[3] ? Number((m[3] + '00000000').substring(0, 9)) : 0;
+		if (m[1]) {
+			seconds = -seconds;
+			nanos = -nanos;
+		}
+	}
+	writer.writeInt64(1, seconds);
+	writer.writeInt32(2, nanos);
+};
+
+/**
+ * Writes a google.protobuf.FieldMask in its JSON form.
+ * @param {*} v The field mask.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeFieldMask_ = function(v, writer) {
+	test.wkt.fieldMaskFromJSON_(v).forEach(function(path) {
+		writer.writeString(1, path);
+	});
+};
+
+/**
+ * Writes a google.protobuf.ListValue in its JSON form.
+ * @param {!Array.<*>} v The array.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeListValue_ = function(v, writer) {
+	v.forEach(function(item) {
+		writer.writeMessage(1, [item], test.wkt.writeValue_);
+	});
+};
+
+/**
+ * Writes a google.protobuf.Struct in its JSON form.
+ * @param {!Object.<string, *>} v The object.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeStruct_ = function(v, writer) {
+	for (var key in v) {
+		writer.writeMessage(1, key, function(key, writer) {
+			writer.writeString(1, key);
+			writer.writeMessage(2, [v[key]], test.wkt.writeValue_);
+		});
+	}
+};
+
+/**
+ * Writes a google.protobuf.Timestamp in its JSON form.
+ * @param {*} v The timestamp.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeTimestamp_ = function(v, writer) {
+	var seconds = Number(v.seconds || 0), nanos = v.nanos || 0;
+	if (typeof v === 'string') {
+		var fraction = /\.(\d+)/.exec(v);
+		seconds = Math.floor(test.wkt.timestampFromJSON_(v).getTime() / 1000);
+		nanos = fraction ? Number((fraction[1] + '00000000').substring(0, 9)) : 0;
+	}
+	writer.writeInt64(1, seconds);
+	writer.writeInt32(2, nanos);
+};
+
+/**
+ * Writes a google.protobuf.Value in its JSON form.
+ * @param {!Array} value The JSON value, boxed in an array as the writer skips
+ *     null messages.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeValue_ = function(value, writer) {
+	var v = value[0];
+	if (v === null) {
+		writer.writeEnum(1, 0);
+	} else if (typeof v === 'number') {
+		writer.writeDouble(2, v);
+	} else if (typeof v === 'string') {
+		writer.writeString(3, v);
+	} else if (typeof v === 'boolean') {
+		writer.writeBool(4, v);
+	} else if (Array.isArray(v)) {
+		writer.writeMessage(6, v, test.wkt.writeListValue_);
+	} else {
+		writer.writeMessage(5, v, test.wkt.writeStruct_);
+	}
+};
+
+/**
+ * The message classes which can be packed in google.protobuf.Any, by full
+ * name, shared by the generated files.
+ * @type {!Object.<string, !Function>}
+ */
+goog.global['jspbAnyTypes'] = goog.global['jspbAnyTypes'] || {};
+
+/**
+ * Registers generated message classes, by their TYPE_NAME, so the
+ * google.protobuf.Any fields of the messages of any generated file can
+ * unpack and serialize them.
+ * @param {...!Function} var_args The classes.
+ */
+test.wkt.registerAnyTypes = function(var_args) {
+	for (var i = 0; i < arguments.length; i++) {
+		goog.global['jspbAnyTypes'][arguments[i].TYPE_NAME] = arguments[i];
+	}
+};
+
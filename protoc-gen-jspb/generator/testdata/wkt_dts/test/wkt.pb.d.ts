@@ -0,0 +1,67 @@
+// Code generated by protoc-gen-js.
+// source: test/wkt.proto
+// DO NOT EDIT!
+
+declare namespace test.wkt {
+	class Record {
+		constructor(jsonData: Object);
+		getJsonData(): Object;
+		static readonly TYPE_NAME: string;
+		getCreated(): Date | null;
+		setCreated(created: Date | null): void;
+		hasCreated(): boolean;
+		clearCreated(): void;
+		getTimeout(): number | null;
+		setTimeout(timeout: number | null): void;
+		hasTimeout(): boolean;
+		clearTimeout(): void;
+		getCount(): number | null;
+		setCount(count: number | null): void;
+		hasCount(): boolean;
+		clearCount(): void;
+		getTotal(): number | null;
+		setTotal(total: number | null): void;
+		hasTotal(): boolean;
+		clearTotal(): void;
+		getBlob(): string | null;
+		setBlob(blob: string | null): void;
+		hasBlob(): boolean;
+		clearBlob(): void;
+		getLabels(): {[key: string]: any} | null;
+		setLabels(labels: {[key: string]: any} | null): void;
+		hasLabels(): boolean;
+		clearLabels(): void;
+		getExtra(): any;
+		setExtra(extra: any): void;
+		hasExtra(): boolean;
+		clearExtra(): void;
+		getItems(): Array<any> | null;
+		setItems(items: Array<any> | null): void;
+		hasItems(): boolean;
+		clearItems(): void;
+		getMask(): Array<string> | null;
+		setMask(mask: Array<string> | null): void;
+		hasMask(): boolean;
+		clearMask(): void;
+		getPayload(): Object | null;
+		setPayload(payload: Object | null): void;
+		hasPayload(): boolean;
+		clearPayload(): void;
+		packPayload(message: {getJsonData(): Object}): void;
+		unpackPayload(): Object | null;
+		getHistory(): Array<Date>;
+		setHistory(history: Array<Date>): void;
+		equals(other: any): boolean;
+		deepCopy(): Object;
+		clone(): test.wkt.Record;
+		mergeFrom(other: test.wkt.Record): void;
+		applyFieldMask(paths: string[]): void;
+		diffFieldMask(other: test.wkt.Record): string[];
+		validate(): {field: string, rule: string, message: string}[];
+		static verify(json: any, path?: string): string[];
+		serializeBinary(): Uint8Array;
+		static deserializeBinary(bytes: Uint8Array | ArrayBuffer | string | number[]): test.wkt.Record;
+	}
+
+	function registerAnyTypes(...types: Function[]): void;
+}
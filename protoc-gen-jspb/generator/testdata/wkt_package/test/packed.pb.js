@@ -0,0 +1,448 @@
+// Code generated by protoc-gen-js.
+// source: test/packed.proto
+// DO NOT EDIT!
+
+goog.provide('test.wkt.Envelope');
+
+goog.require('goog.crypt.base64');
+goog.require('jspb.BinaryReader');
+goog.require('jspb.BinaryWriter');
+
+/**
+ * @param {Object} jsonData The JSON data.
+ * @constructor
+ */
+test.wkt.Envelope = function(jsonData) {
+	/**
+	 * @private {Object}
+	 */
+	this.jsonData_ = jsonData;
+};
+
+/**
+ * @return {Object} The JSON data.
+ */
+test.wkt.Envelope.prototype.getJsonData = function() {
+	return this.jsonData_;
+};
+
+/**
+ * The full name of the message type.
+ * @const {string}
+ */
+test.wkt.Envelope.TYPE_NAME = 'test.wkt.Envelope';
+
+/**
+ * @return {?Object}
+ */
+test.wkt.Envelope.prototype.getContent = function() {
+	var v = this.jsonData_["content"];
+	return v != null ? v : null;
+};
+
+/**
+ * @param {?Object} content The content.
+ */
+test.wkt.Envelope.prototype.setContent = function(content) {
+	this.jsonData_["content"] = content;
+};
+
+/**
+ * @return {boolean} Whether the content is set.
+ */
+test.wkt.Envelope.prototype.hasContent = function() {
+	return this.jsonData_["content"] != null;
+};
+
+/**
+ * Clears the content.
+ */
+test.wkt.Envelope.prototype.clearContent = function() {
+	delete this.jsonData_["content"];
+};
+
+/**
+ * Packs the message into the content.
+ * @param {!Object} message The message, of a generated class.
+ */
+test.wkt.Envelope.prototype.packContent = function(message) {
+	var __data = {'@type': 'type.googleapis.com/' + message.constructor.TYPE_NAME};
+	var __json = message.getJsonData();
+	for (var __key in __json) {
+		__data[__key] = __json[__key];
+	}
+	this.setContent(__data);
+};
+
+/**
+ * @return {Object} The message packed in the content, or null if it's unset or
+ *     its type isn't registered.
+ */
+test.wkt.Envelope.prototype.unpackContent = function() {
+	var v = this.getContent();
+	var __type = v && test.wkt.anyType_(v['@type'] || '');
+	if (!__type) {
+		return null;
+	}
+	var __data = {};
+	for (var __key in v) {
+		if (__key !== '@type') {
+			__data[__key] = v[__key];
+		}
+	}
+	return new __type(__data);
+};
+
+/**
+ * Compares the message with another one field by field. Unset fields equal
+ * fields set to their defaults, even when the fields track their presence,
+ * and NaN equals NaN.
+ * @param {*} other The other message.
+ * @return {boolean} Whether the messages are equal.
+ */
+test.wkt.Envelope.prototype.equals = function(other) {
+	if (!(other instanceof test.wkt.Envelope)) {
+		return false;
+	}
+	if (!test.wkt.jsonEquals_(this.getContent(), other.getContent())) {
+		return false;
+	}
+	return true;
+};
+
+/**
+ * @return {!Object} A deep copy of the JSON data of the message.
+ */
+test.wkt.Envelope.prototype.deepCopy = function() {
+	return test.wkt.copyJSON_(this.jsonData_);
+};
+
+/**
+ * @return {!test.wkt.Envelope} A copy of the message, sharing no data with it.
+ */
+test.wkt.Envelope.prototype.clone = function() {
+	return new test.wkt.Envelope(this.deepCopy());
+};
+
+/**
+ * Merges another message into the message: the fields set in the other
+ * message overwrite the singular fields, the repeated fields are
+ * appended, the messages are merged recursively, the maps are merged by
+ * key and the oneofs take the member set in the other message. The values
+ * are copied.
+ * @param {!test.wkt.Envelope} other The other message.
+ */
+test.wkt.Envelope.prototype.mergeFrom = function(other) {
+	var v;
+	v = other.jsonData_["content"];
+	if (other.hasContent()) {
+		this.jsonData_["content"] = test.wkt.copyJSON_(v);
+	}
+};
+
+/**
+ * Prunes the message to the fields of the paths, clearing the other fields.
+ * The paths naming fields of the singular message fields prune the messages.
+ * @param {!Array.<string>} paths The paths of a field mask.
+ */
+test.wkt.Envelope.prototype.applyFieldMask = function(paths) {
+	var p;
+	p = test.wkt.fieldMaskPaths_(paths, "content");
+	if (p !== true) {
+		delete this.jsonData_["content"];
+	}
+};
+
+/**
+ * Computes the paths of the fields differing between the message and another
+ * one, descending into the singular message fields set in both messages.
+ * Applied to the other message, they give the fields updated by the message.
+ * @param {!test.wkt.Envelope} other The other message.
+ * @return {!Array.<string>} The paths of a field mask.
+ */
+test.wkt.Envelope.prototype.diffFieldMask = function(other) {
+	var paths = [];
+	if (this.hasContent() !== other.hasContent() || !test.wkt.jsonEquals_(this.getContent(), other.getContent())) {
+		paths.push("content");
+	}
+	return paths;
+};
+
+/**
+ * Checks the message against the validation rules of its fields, and the
+ * messages of its fields against theirs. The fields with presence are only
+ * checked when set.
+ * @return {!Array.<{field: string, rule: string, message: string}>} The
+ *     violations of the rules, with the paths of the fields, or an empty
+ *     array if the message is valid.
+ */
+test.wkt.Envelope.prototype.validate = function() {
+	var violations = [];
+	return violations;
+};
+
+/**
+ * Checks that the JSON data is of the JSON types expected by the accessors
+ * of the message, with the integers in the range of their types and the
+ * required fields set, and so are the JSON data of the messages of its
+ * fields.
+ * @param {*} json The JSON data.
+ * @param {string=} opt_path The path of the JSON data in the errors, empty
+ *     by default.
+ * @return {!Array.<string>} The errors, prefixed with the paths of the
+ *     values, like "items[0].name: expected a string, got number 1", or
+ *     an empty array if the JSON data is valid.
+ */
+test.wkt.Envelope.verify = function(json, opt_path) {
+	var path = opt_path || '';
+	if (json === null || typeof json !== 'object' || Array.isArray(json)) {
+		return [test.wkt.verifyError_(path, 'an object', json)];
+	}
+	var prefix = path ? path + '.' : '';
+	var errors = [];
+	test.wkt.verifyValue_(errors, json["content"], prefix + "content", test.wkt.verifyObject_);
+	return errors;
+};
+
+/**
+ * Serializes the message to the binary wire format.
+ * @return {!Uint8Array} The serialized message.
+ */
+test.wkt.Envelope.prototype.serializeBinary = function() {
+	var writer = new jspb.BinaryWriter();
+	test.wkt.Envelope.serializeBinaryToWriter(this, writer);
+	return writer.getResultBuffer();
+};
+
+/**
+ * Writes the message to the writer in the binary wire format.
+ * @param {!test.wkt.Envelope} message The message.
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.Envelope.serializeBinaryToWriter = function(message, writer) {
+	var v;
+	v = message.jsonData_["content"];
+	if (v != null) {
+		writer.writeMessage(1, v, test.wkt.writeAny_);
+	}
+};
+
+/**
+ * Deserializes a message from the binary wire format.
+ * @param {jspb.ByteSource} bytes The serialized message.
+ * @return {!test.wkt.Envelope} The message.
+ */
+test.wkt.Envelope.deserializeBinary = function(bytes) {
+	var reader = new jspb.BinaryReader(bytes);
+	return test.wkt.Envelope.deserializeBinaryFromReader(new test.wkt.Envelope({}), reader);
+};
+
+/**
+ * Reads the fields of the message from the reader in the binary wire format.
+ * @param {!test.wkt.Envelope} message The message.
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!test.wkt.Envelope} The message.
+ */
+test.wkt.Envelope.deserializeBinaryFromReader = function(message, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		var value;
+		switch (reader.getFieldNumber()) {
+		case 1:
+			message.jsonData_["content"] = test.wkt.readAny_(reader);
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+	return message;
+};
+
+/**
+ * Looks up the registered class of the message type of a type URL.
+ * @param {string} url The type URL of a google.protobuf.Any.
+ * @return {?Function} The class, or null if it isn't registered.
+ */
+test.wkt.anyType_ = function(url) {
+	var name = url.substring(url.lastIndexOf('/') + 1);
+	return Object.prototype.hasOwnProperty.call(goog.global['jspbAnyTypes'], name) ? goog.global['jspbAnyTypes'][name] : null;
+};
+
+/**
+ * Copies a JSON value deeply. Unlike a round trip through JSON.stringify,
+ * it keeps NaN and the infinities.
+ * @param {*} v The value.
+ * @return {?} The copy.
+ */
+test.wkt.copyJSON_ = function(v) {
+	if (Array.isArray(v)) {
+		return v.map(test.wkt.copyJSON_);
+	}
+	if (v !== null && typeof v === 'object') {
+		var copy = {};
+		for (var key in v) {
+			if (Object.prototype.hasOwnProperty.call(v, key)) {
+				copy[key] = test.wkt.copyJSON_(v[key]);
+			}
+		}
+		return copy;
+	}
+	return v;
+};
+
+/**
+ * Looks up a field in the paths of a field mask.
+ * @param {!Array.<string>} paths The paths.
+ * @param {string} name The name of the field.
+ * @return {boolean|!Array.<string>} True if the paths include the field, or the
+ *     paths of its fields, relative to the field.
+ */
+test.wkt.fieldMaskPaths_ = function(paths, name) {
+	var sub = [];
+	for (var i = 0; i < paths.length; i++) {
+		if (paths[i] === name) {
+			return true;
+		}
+		if (paths[i].lastIndexOf(name + '.', 0) === 0) {
+			sub.push(paths[i].substring(name.length + 1));
+		}
+	}
+	return sub;
+};
+
+/**
+ * Compares two JSON values deeply, NaN being equal to NaN.
+ * @param {*} a The first value.
+ * @param {*} b The second value.
+ * @return {boolean} Whether the values are equal.
+ */
+test.wkt.jsonEquals_ = function(a, b) {
+	if (a === b || (a !== a && b !== b)) {
+		return true;
+	}
+	if (a === null || b === null || typeof a !== 'object' || typeof b !== 'object' ||
+			Array.isArray(a) !== Array.isArray(b)) {
+		return false;
+	}
+	var keys = Object.keys(a);
+	if (keys.length !== Object.keys(b).length) {
+		return false;
+	}
+	for (var i = 0; i < keys.length; i++) {
+		if (!Object.prototype.hasOwnProperty.call(b, keys[i]) || !test.wkt.jsonEquals_(a[keys[i]], b[keys[i]])) {
+			return false;
+		}
+	}
+	return true;
+};
+
+/**
+ * Reads a google.protobuf.Any into its JSON form. The packed message is
+ * deserialized by its registered class; the value of an unregistered type is
+ * kept as a base64 string in "value".
+ * @param {!jspb.BinaryReader} reader The reader.
+ * @return {!Object} The JSON object with the type URL in "@type".
+ */
+test.wkt.readAny_ = function(reader) {
+	var any = {url: '', bytes: null};
+	reader.readMessage(any, function(any, reader) {
+		while (reader.nextField()) {
+			if (reader.isEndGroup()) {
+				break;
+			}
+			switch (reader.getFieldNumber()) {
+			case 1:
+				any.url = reader.readString();
+				break;
+			case 2:
+				any.bytes = reader.readBytes();
+				break;
+			default:
+				reader.skipField();
+			}
+		}
+	});
+	var v = {'@type': any.url};
+	var type = test.wkt.anyType_(any.url);
+	if (type && any.bytes) {
+		var data = type.deserializeBinary(any.bytes).getJsonData();
+		for (var key in data) {
+			v[key] = data[key];
+		}
+	} else if (any.bytes) {
+		v['value'] = goog.crypt.base64.encodeByteArray(any.bytes);
+	}
+	return v;
+};
+
+/**
+ * Describes a JSON value not of the expected type.
+ * @param {string} path The path of the value, or an empty string.
+ * @param {string} expected The expected type.
+ * @param {*} v The value.
+ * @return {string} The error.
+ */
+test.wkt.verifyError_ = function(path, expected, v) {
+	var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+	if (got === 'string' || got === 'number') {
+		got += ' ' + JSON.stringify(v);
+	}
+	return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;
+};
+
+/**
+ * Checks that a JSON value is an object.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @return {!Array.<string>} The errors.
+ */
+test.wkt.verifyObject_ = function(v, path) {
+	return typeof v === 'object' && !Array.isArray(v) ? [] : [test.wkt.verifyError_(path, 'an object', v)];
+};
+
+/**
+ * Checks a JSON value of a singular field, unless unset.
+ * @param {!Array.<string>} errors The errors, appended to.
+ * @param {*} v The value.
+ * @param {string} path The path of the value.
+ * @param {function(*, string): !Array.<string>} check The check of the value.
+ */
+test.wkt.verifyValue_ = function(errors, v, path, check) {
+	if (v != null) {
+		Array.prototype.push.apply(errors, check(v, path));
+	}
+};
+
+/**
+ * Writes a google.protobuf.Any in its JSON form. The packed message is
+ * serialized by its registered class; the value of an unregistered type is
+ * written as is.
+ * @param {!Object} v The JSON object with the type URL in "@type".
+ * @param {!jspb.BinaryWriter} writer The writer.
+ */
+test.wkt.writeAny_ = function(v, writer) {
+	var url = v['@type'] || '';
+	var type = test.wkt.anyType_(url);
+	writer.writeString(1, url);
+	if (type) {
+		var data = {};
+		for (var key in v) {
+			if (key !== '@type') {
+				data[key] = v[key];
+			}
+		}
+		writer.writeBytes(2, new type(data).serializeBinary());
+	} else if (v['value'] != null) {
+		writer.writeBytes(2, v['value']);
+	}
+};
+
+/**
+ * The message classes which can be packed in google.protobuf.Any, by full
+ * name, shared by the generated files.
+ * @type {!Object.<string, !Function>}
+ */
+goog.global['jspbAnyTypes'] = goog.global['jspbAnyTypes'] || {};
+
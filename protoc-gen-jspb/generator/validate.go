@@ -0,0 +1,629 @@
+/*
+ * Validation of the generated messages against the rules of the
+ * protoc-gen-validate (validate.rules) and protovalidate (buf.validate.field)
+ * options of their fields. The options are read from the unknown fields of
+ * the field options, the two plugins numbering their rules the same way, so
+ * no code needs to be generated for their .proto files.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"fmt"
+	"math"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The field numbers of the field options holding the validation rules.
+const (
+	pgvRulesField = 1071 // validate.rules
+	bufRulesField = 1159 // buf.validate.field
+)
+
+// The field numbers of the validation rules, the same in validate.FieldRules
+// and buf.validate.FieldRules.
+const (
+	stringRulesField   = 14
+	enumRulesField     = 16
+	messageRulesField  = 17 // protoc-gen-validate only
+	repeatedRulesField = 18
+	requiredRuleField  = 25 // protovalidate only
+	ignoreRuleField    = 27 // protovalidate only
+	ignoreAlways       = 3
+)
+
+// The names of the rules of the numbers, by field number in the field rules.
+var numberRuleKinds = map[int]string{
+	1: "float", 2: "double", 3: "int32", 4: "int64", 5: "uint32", 6: "uint64",
+	7: "sint32", 8: "sint64", 9: "fixed32", 10: "fixed64", 11: "sfixed32", 12: "sfixed64",
+}
+
+// The names of the bounds of the numbers, by field number in the rules of the
+// numbers.
+var numberRuleOps = map[int]string{2: "lt", 3: "lte", 4: "gt", 5: "gte"}
+
+// The names of the length rules of the strings and of the repeated fields, by
+// field number in their rules.
+var (
+	stringRuleOps   = map[int]string{2: "min_len", 3: "max_len", 19: "len"}
+	repeatedRuleOps = map[int]string{1: "min_items", 2: "max_items"}
+)
+
+// The comparisons and the descriptions of the bounds of the numbers.
+var (
+	numberRuleCmps  = map[string]string{"lt": " < ", "lte": " <= ", "gt": " > ", "gte": " >= "}
+	numberRuleDescs = map[string]string{"lt": "less than ", "lte": "less than or equal to ",
+		"gt": "greater than ", "gte": "greater than or equal to "}
+)
+
+// fieldRules are the validation rules of a field.
+type fieldRules struct {
+	required bool
+	skip     bool // the messages of the field aren't validated
+	checks   []ruleCheck
+	items    *fieldRules // the rules of the elements of a repeated field
+}
+
+// ruleCheck is a rule checking the values of a field.
+type ruleCheck struct {
+	id    string  // the id of the rule, like "string.min_len"
+	value string  // the parameter of the rule, as a JavaScript literal
+	num   float64 // the parameter of the rules of the numbers
+}
+
+// op returns the name of the rule in its type of rules, like "min_len".
+func (c ruleCheck) op() string {
+	return c.id[strings.LastIndex(c.id, ".")+1:]
+}
+
+// forEachField calls f with the number, the wire type and the value of the
+// fields of the encoded message b, the varints and fixed numbers in x and the
+// length-delimited fields in data.
+func forEachField(b []byte, f func(num, wire int, x uint64, data []byte) error) error {
+	buf := proto.NewBuffer(b)
+	for len(buf.Unread()) > 0 {
+		key, err := buf.DecodeVarint()
+		if err != nil {
+			return err
+		}
+		num, wire := int(key>>3), int(key&7)
+		var x uint64
+		var data []byte
+		switch wire {
+		case 0:
+			x, err = buf.DecodeVarint()
+		case 1:
+			x, err = buf.DecodeFixed64()
+		case 2:
+			data, err = buf.DecodeRawBytes(false)
+		case 5:
+			x, err = buf.DecodeFixed32()
+		default:
+			err = fmt.Errorf("unsupported wire type %d", wire)
+		}
+		if err == nil {
+			err = f(num, wire, x, data)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validationRules returns the validation rules of the field, or nil if it
+// has none.
+func (g *Generator) validationRules(field *descriptor.FieldDescriptorProto) *fieldRules {
+	if field.Options == nil {
+		return nil
+	}
+	b, err := proto.Marshal(field.Options)
+	if err != nil {
+		g.Error(err, "failed to marshal the options of", field.GetName())
+	}
+	var rules *fieldRules
+	err = forEachField(b, func(num, wire int, x uint64, data []byte) error {
+		if (num == pgvRulesField || num == bufRulesField) && wire == 2 {
+			var err error
+			rules, err = parseFieldRules(data)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		g.Error(err, "malformed validation rules of", field.GetName())
+	}
+	return rules
+}
+
+// parseFieldRules parses the encoded field rules.
+func parseFieldRules(b []byte) (*fieldRules, error) {
+	rules := &fieldRules{}
+	ignore := false
+	err := forEachField(b, func(num, wire int, x uint64, data []byte) error {
+		if kind, ok := numberRuleKinds[num]; ok {
+			return forEachField(data, func(n, _ int, x uint64, _ []byte) error {
+				if op := numberRuleOps[n]; op != "" {
+					value, f := ruleNumber(num, x)
+					rules.checks = append(rules.checks, ruleCheck{kind + "." + op, value, f})
+				}
+				return nil
+			})
+		}
+		switch num {
+		case stringRulesField:
+			return forEachField(data, func(n, _ int, x uint64, data []byte) error {
+				if op := stringRuleOps[n]; op != "" {
+					rules.checks = append(rules.checks, ruleCheck{"string." + op, strconv.FormatUint(x, 10), float64(x)})
+				} else if n == 6 {
+					rules.checks = append(rules.checks, ruleCheck{"string.pattern", string(data), 0})
+				}
+				return nil
+			})
+		case enumRulesField:
+			return forEachField(data, func(n, _ int, x uint64, _ []byte) error {
+				if n == 2 && x != 0 {
+					rules.checks = append(rules.checks, ruleCheck{"enum.defined_only", "", 0})
+				}
+				return nil
+			})
+		case messageRulesField:
+			return forEachField(data, func(n, _ int, x uint64, _ []byte) error {
+				switch n {
+				case 1:
+					rules.skip = x != 0
+				case 2:
+					rules.required = x != 0
+				}
+				return nil
+			})
+		case repeatedRulesField:
+			return forEachField(data, func(n, _ int, x uint64, data []byte) error {
+				if op := repeatedRuleOps[n]; op != "" {
+					rules.checks = append(rules.checks, ruleCheck{"repeated." + op, strconv.FormatUint(x, 10), float64(x)})
+				} else if n == 4 {
+					var err error
+					rules.items, err = parseFieldRules(data)
+					return err
+				}
+				return nil
+			})
+		case requiredRuleField:
+			rules.required = x != 0
+		case ignoreRuleField:
+			ignore = x == ignoreAlways
+		}
+		return nil
+	})
+	if ignore {
+		return &fieldRules{skip: true}, err
+	}
+	return rules, err
+}
+
+// ruleNumber decodes the parameter of a rule of the numbers of the kind, by
+// field number in the field rules, into a JavaScript literal and its value.
+func ruleNumber(kind int, x uint64) (string, float64) {
+	switch kind {
+	case 1:
+		f := math.Float32frombits(uint32(x))
+		return strconv.FormatFloat(float64(f), 'g', -1, 32), float64(f)
+	case 2:
+		f := math.Float64frombits(x)
+		return strconv.FormatFloat(f, 'g', -1, 64), f
+	case 3, 11:
+		return strconv.FormatInt(int64(int32(x)), 10), float64(int32(x))
+	case 4, 12:
+		return strconv.FormatInt(int64(x), 10), float64(int64(x))
+	case 7:
+		n := int32(uint32(x)>>1) ^ -int32(x&1)
+		return strconv.FormatInt(int64(n), 10), float64(n)
+	case 8:
+		n := int64(x>>1) ^ -int64(x&1)
+		return strconv.FormatInt(n, 10), float64(n)
+	}
+	return strconv.FormatUint(x, 10), float64(x)
+}
+
+// generateValidate generates the validate method of the message, given the
+// names of the getters and presence checks of its fields.
+func (g *Generator) generateValidate(message *Descriptor, className string, getters, hasNames map[*descriptor.FieldDescriptorProto]string) {
+	g.declare(className, "validate(): {field: string, rule: string, message: string}[];")
+
+	g.P("/**")
+	g.P(" * Checks the message against the validation rules of its fields, and the")
+	g.P(" * messages of its fields against theirs. The fields with presence are only")
+	g.P(" * checked when set.")
+	g.P(" * @return {!Array.<{field: string, rule: string, message: string}>} The")
+	g.P(" *     violations of the rules, with the paths of the fields, or an empty")
+	g.P(" *     array if the message is valid.")
+	g.P(" */")
+	g.P(className, ".prototype.validate = function() {")
+	g.In()
+	g.P("var violations = [];")
+	validated := make(map[*descriptor.FieldDescriptorProto]*fieldRules)
+	nested := make(map[*descriptor.FieldDescriptorProto]bool) // whether the messages of the field are validated
+	for i, field := range message.Field {
+		g.path = fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i)
+		r := g.validationRules(field)
+		if r == nil {
+			r = &fieldRules{}
+		}
+		if entry := g.mapEntry(field); entry != nil {
+			nested[field] = isMessage(entry.Field[1]) && !r.skip
+		} else {
+			nested[field] = isMessage(field) && !r.skip && (r.items == nil || !r.items.skip)
+		}
+		if r.required || len(r.checks) > 0 || r.items != nil || nested[field] {
+			validated[field] = r
+		}
+	}
+	if len(validated) > 0 {
+		g.P("var v;")
+	}
+	for i, field := range message.Field {
+		rules := validated[field]
+		if rules == nil {
+			continue
+		}
+		g.path = fmt.Sprintf("%s,%d,%d", message.path, messageFieldPath, i)
+		path := jsString(field.GetName())
+		// The paths of the elements and of the map values.
+		itemPath := jsString(field.GetName()+"[") + " + __index + \"]\""
+		valuePath := jsString(field.GetName()+"[\"") + " + __key + \"\\\"]\""
+		value := "this." + getters[field] + "()"
+
+		// The fields with presence are checked when set, the others always.
+		present := ""
+		if hasPresence(field, message.proto3()) {
+			present = "this." + hasNames[field] + "()"
+		}
+		if rules.required && present == "" {
+			switch {
+			case g.mapEntry(field) != nil:
+				g.P("if (!Object.keys(", value, ").length) {")
+			case isRepeated(field):
+				g.P("if (!", value, ".length) {")
+			default:
+				g.P("if (!this.", hasNames[field], "()) {")
+			}
+			g.In()
+			g.generateViolation(path, "required", "value is required")
+			g.Out()
+			g.P("}")
+		}
+		if present != "" {
+			g.P("if (", present, ") {")
+			g.In()
+		}
+		if len(rules.checks) > 0 || rules.items != nil || nested[field] {
+			g.P("v = ", value, ";")
+		}
+		g.generateRuleChecks(field, rules.checks, path, "v")
+		if rules.items != nil && len(rules.items.checks) > 0 {
+			g.P(g.forEach("v", "__item, __index"))
+			g.In()
+			g.generateRuleChecks(field, rules.items.checks, itemPath, "__item")
+			g.Out()
+			g.P("}, this);")
+		}
+		if nested[field] {
+			switch {
+			case g.mapEntry(field) != nil:
+				g.P("for (var __key in v) {")
+				g.In()
+				g.P(g.helper("nestedViolations"), "(violations, ", valuePath, ", v[__key].validate());")
+				g.Out()
+				g.P("}")
+			case isRepeated(field):
+				g.P(g.forEach("v", "__item, __index"))
+				g.In()
+				g.P(g.helper("nestedViolations"), "(violations, ", itemPath, ", __item.validate());")
+				g.Out()
+				g.P("}, this);")
+			default:
+				g.P(g.helper("nestedViolations"), "(violations, ", path, ", v.validate());")
+			}
+		}
+		if present != "" {
+			g.Out()
+			if rules.required {
+				g.P("} else {")
+				g.In()
+				g.generateViolation(path, "required", "value is required")
+				g.Out()
+			}
+			g.P("}")
+		}
+	}
+	g.path = message.path
+	g.P("return violations;")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// generateRuleChecks generates the checks of the rules of the field against
+// value, a single value of the field or the array of the repeated field,
+// reporting the violations with path, the expression of the path of the
+// value.
+func (g *Generator) generateRuleChecks(field *descriptor.FieldDescriptorProto, checks []ruleCheck, path, value string) {
+	// The numbers are compared as JavaScript numbers.
+	num := value
+	switch g.int64Type(field) {
+	case int64String:
+		num = "Number(" + value + ")"
+	case int64Long:
+		num = value + ".toNumber()"
+	}
+	// A lower bound above the upper bound excludes the range between them.
+	lower, upper := -1, -1
+	for i, check := range checks {
+		switch check.op() {
+		case "gt", "gte":
+			lower = i
+		case "lt", "lte":
+			upper = i
+		}
+	}
+	exclusive := lower >= 0 && upper >= 0 && checks[lower].num > checks[upper].num
+	for i, check := range checks {
+		var cond, msg string
+		switch check.id {
+		case "string.len":
+			cond = g.helper("runeCount") + "(" + value + ") !== " + check.value
+			msg = "value length must be " + check.value + " characters"
+		case "string.min_len":
+			cond = g.helper("runeCount") + "(" + value + ") < " + check.value
+			msg = "value length must be at least " + check.value + " characters"
+		case "string.max_len":
+			cond = g.helper("runeCount") + "(" + value + ") > " + check.value
+			msg = "value length must be at most " + check.value + " characters"
+		case "string.pattern":
+			re, err := jsRegExp(check.value)
+			if err != nil {
+				g.Fail("bad pattern of", field.GetName()+":", err.Error())
+			}
+			cond = "!new RegExp(" + jsString(re) + ", 'u').test(" + value + ")"
+			msg = "value does not match regex pattern " + check.value
+		case "enum.defined_only":
+			cond = "!" + g.enumUtilName(g.objectNamed(field.GetTypeName())) + ".isValid(" + value + ")"
+			msg = "value must be one of the defined enum values"
+		case "repeated.min_items":
+			cond = value + ".length < " + check.value
+			msg = "value must contain at least " + check.value + " item(s)"
+		case "repeated.max_items":
+			cond = value + ".length > " + check.value
+			msg = "value must contain no more than " + check.value + " item(s)"
+		default:
+			op := check.op()
+			switch {
+			case !exclusive:
+				cond = "!(" + num + numberRuleCmps[op] + check.value + ")"
+				msg = "value must be " + numberRuleDescs[op] + check.value
+			case i == lower:
+				uop, upper := checks[upper].op(), checks[upper].value
+				cond = "!(" + num + numberRuleCmps[op] + check.value + " || " + num + numberRuleCmps[uop] + upper + ")"
+				msg = "value must be " + numberRuleDescs[op] + check.value + " or " + numberRuleDescs[uop] + upper
+			default:
+				continue
+			}
+		}
+		g.P("if (", cond, ") {")
+		g.In()
+		g.generateViolation(path, check.id, msg)
+		g.Out()
+		g.P("}")
+	}
+}
+
+// jsRegExp translates the RE2 pattern of a string.pattern rule into the
+// source of a JavaScript regular expression with the u flag, matching the
+// same strings. The syntax of RE2 differs from the JavaScript one, with
+// flag groups like (?i), \z or (?P<name>...), and some of its meanings too,
+// like . not matching \r or ^ matching after \r in multi-line mode, so the
+// pattern is parsed and written out again rather than copied.
+func jsRegExp(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := writeJsRegExp(&b, re); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// writeJsRegExp writes the JavaScript source of the parsed RE2 expression.
+func writeJsRegExp(b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		b.WriteString("[]")
+	case syntax.OpEmptyMatch:
+		b.WriteString("(?:)")
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase == 0 {
+				b.WriteString(jsRegExpRune(r, false))
+				continue
+			}
+			// The rune in any case.
+			folds := []rune{r}
+			for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+				folds = append(folds, f)
+			}
+			if len(folds) == 1 {
+				b.WriteString(jsRegExpRune(r, false))
+				continue
+			}
+			b.WriteString("[")
+			for _, f := range folds {
+				b.WriteString(jsRegExpRune(f, true))
+			}
+			b.WriteString("]")
+		}
+	case syntax.OpCharClass:
+		ranges := re.Rune
+		b.WriteString("[")
+		// A class running to the last rune is written as the negation of
+		// the runes it leaves out, as it was most likely written.
+		if n := len(ranges); n > 0 && ranges[n-1] == unicode.MaxRune && (ranges[0] == 0 || n > 2) {
+			b.WriteString("^")
+			var out []rune
+			if ranges[0] > 0 {
+				out = append(out, 0, ranges[0]-1)
+			}
+			for i := 1; i+1 < n; i += 2 {
+				out = append(out, ranges[i]+1, ranges[i+1]-1)
+			}
+			ranges = out
+		}
+		for i := 0; i+1 < len(ranges); i += 2 {
+			b.WriteString(jsRegExpRune(ranges[i], true))
+			if ranges[i+1] != ranges[i] {
+				b.WriteString("-")
+				b.WriteString(jsRegExpRune(ranges[i+1], true))
+			}
+		}
+		b.WriteString("]")
+	case syntax.OpAnyCharNotNL:
+		b.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		b.WriteString(`[\s\S]`)
+	case syntax.OpBeginLine:
+		b.WriteString(`(?<![^\n])`)
+	case syntax.OpEndLine:
+		b.WriteString(`(?![^\n])`)
+	case syntax.OpBeginText:
+		b.WriteString("^")
+	case syntax.OpEndText:
+		b.WriteString("$")
+	case syntax.OpWordBoundary:
+		b.WriteString(`\b`)
+	case syntax.OpNoWordBoundary:
+		b.WriteString(`\B`)
+	case syntax.OpCapture:
+		b.WriteString("(")
+		if err := writeJsRegExp(b, re.Sub[0]); err != nil {
+			return err
+		}
+		b.WriteString(")")
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		if err := writeJsRegExpAtom(b, re.Sub[0]); err != nil {
+			return err
+		}
+		switch re.Op {
+		case syntax.OpStar:
+			b.WriteString("*")
+		case syntax.OpPlus:
+			b.WriteString("+")
+		case syntax.OpQuest:
+			b.WriteString("?")
+		default:
+			switch {
+			case re.Max == -1:
+				fmt.Fprintf(b, "{%d,}", re.Min)
+			case re.Max == re.Min:
+				fmt.Fprintf(b, "{%d}", re.Min)
+			default:
+				fmt.Fprintf(b, "{%d,%d}", re.Min, re.Max)
+			}
+		}
+		if re.Flags&syntax.NonGreedy != 0 {
+			b.WriteString("?")
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpAlternate {
+				if err := writeJsRegExpAtom(b, sub); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := writeJsRegExp(b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		for i, sub := range re.Sub {
+			if i > 0 {
+				b.WriteString("|")
+			}
+			if err := writeJsRegExp(b, sub); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%s is not supported in JavaScript", re)
+	}
+	return nil
+}
+
+// writeJsRegExpAtom writes the JavaScript source of the parsed RE2
+// expression as a single atom, in a group if needed.
+func writeJsRegExpAtom(b *strings.Builder, re *syntax.Regexp) error {
+	switch {
+	case re.Op == syntax.OpLiteral && len(re.Rune) == 1,
+		re.Op == syntax.OpCharClass, re.Op == syntax.OpAnyChar, re.Op == syntax.OpAnyCharNotNL,
+		re.Op == syntax.OpCapture, re.Op == syntax.OpNoMatch:
+		return writeJsRegExp(b, re)
+	}
+	b.WriteString("(?:")
+	if err := writeJsRegExp(b, re); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+// jsRegExpRune returns the JavaScript source of the rune in a regular
+// expression with the u flag, in a character class or not.
+func jsRegExpRune(r rune, inClass bool) string {
+	switch {
+	case strings.ContainsRune(`\^$.*+?()[]{}|/`, r) || inClass && r == '-':
+		return `\` + string(r)
+	case r >= 0x20 && r < 0x7f:
+		return string(r)
+	}
+	return fmt.Sprintf(`\u{%x}`, r)
+}
+
+// generateViolation generates the statement reporting the violation of the
+// rule.
+func (g *Generator) generateViolation(path, rule, msg string) {
+	g.P("violations.push({field: ", path, ", rule: ", jsString(rule), ", message: ", jsString(msg), "});")
+}
+
+// The helper functions of the validation.
+var validateHelpers = map[string]jsHelper{
+	"nestedViolations": {
+		doc: `Appends the violations of the rules of a message field to the violations
+of the message.
+@param {!Array.<{field: string, rule: string, message: string}>} violations
+    The violations of the message.
+@param {string} path The path of the field.
+@param {!Array.<{field: string, rule: string, message: string}>} nested The
+    violations of the message of the field.`,
+		params: "violations, path, nested",
+		body: `for (var i = 0; i < nested.length; i++) {
+	violations.push({field: path + '.' + nested[i].field, rule: nested[i].rule, message: nested[i].message});
+}`,
+	},
+	"runeCount": {
+		doc: `Counts the characters of a string, the Unicode code points rather than the
+UTF-16 code units.
+@param {string} s The string.
+@return {number} The number of characters.`,
+		params: "s",
+		body:   `return s.replace(/[\uD800-\uDBFF][\uDC00-\uDFFF]/g, '_').length;`,
+	},
+}
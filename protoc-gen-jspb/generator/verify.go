@@ -0,0 +1,314 @@
+/*
+ * Verification of the JSON data given to the generated messages. The
+ * constructors store the JSON data as is, so the static verify() of the
+ * classes checks beforehand that the values are of the JSON types the
+ * accessors expect, with the json and int64 parameters in effect.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"strconv"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// verifyCheck returns the expression of the function checking the JSON form
+// of a single value of the field, or "" if any JSON value is accepted.
+func (g *Generator) verifyCheck(field *descriptor.FieldDescriptorProto) string {
+	if value := wrappedField(wellKnownType(field)); value != nil {
+		// The wrappers are in the JSON form of the wrapped values.
+		field = value
+	}
+	switch wellKnownType(field) {
+	case "":
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return g.helper("verifyString")
+	case "google.protobuf.ListValue":
+		return g.helper("verifyList")
+	case "google.protobuf.Value":
+		return ""
+	default:
+		return g.helper("verifyObject")
+	}
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return g.jsName(g.objectNamed(field.GetTypeName())) + ".verify"
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		// The values of the closed enums of proto2 must be defined.
+		enum := g.objectNamed(field.GetTypeName())
+		return g.helper("verifyEnum") + "(" + g.enumUtilName(enum) + ", " + strconv.FormatBool(g.CanonicalJson) + ", " +
+			strconv.FormatBool(!fileIsProto3(enum.File())) + ")"
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		return g.helper("verifyBool")
+	case descriptor.FieldDescriptorProto_TYPE_STRING,
+		descriptor.FieldDescriptorProto_TYPE_BYTES:
+		return g.helper("verifyString")
+	case descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+		descriptor.FieldDescriptorProto_TYPE_FLOAT:
+		if g.CanonicalJson {
+			return g.helper("verifyFloat")
+		}
+		return g.helper("verifyNumber")
+	}
+	bits, unsigned := "32", false
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_INT64,
+		descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		bits = "64"
+	case descriptor.FieldDescriptorProto_TYPE_UINT64,
+		descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		bits, unsigned = "64", true
+	case descriptor.FieldDescriptorProto_TYPE_UINT32,
+		descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		unsigned = true
+	}
+	switch g.int64Type(field) {
+	case int64String, int64Long:
+		return g.helper("verifyDecimal") + "(" + strconv.FormatBool(unsigned) + ")"
+	}
+	// The canonical JSON mapping accepts the integers as decimal strings.
+	return g.helper("verifyInteger") + "(" + bits + ", " + strconv.FormatBool(unsigned) + ", " +
+		strconv.FormatBool(g.CanonicalJson) + ")"
+}
+
+// generateVerify generates the static verify method of the message.
+func (g *Generator) generateVerify(message *Descriptor, className string) {
+	g.declare(className, "static verify(json: any, path?: string): string[];")
+
+	g.P("/**")
+	g.P(" * Checks that the JSON data is of the JSON types expected by the accessors")
+	g.P(" * of the message, with the integers in the range of their types and the")
+	g.P(" * required fields set, and so are the JSON data of the messages of its")
+	g.P(" * fields.")
+	g.P(" * @param {*} json The JSON data.")
+	g.P(" * @param {string=} opt_path The path of the JSON data in the errors, empty")
+	g.P(" *     by default.")
+	g.P(" * @return {!Array.<string>} The errors, prefixed with the paths of the")
+	g.P(" *     values, like \"items[0].name: expected a string, got number 1\", or")
+	g.P(" *     an empty array if the JSON data is valid.")
+	g.P(" */")
+	g.P(className, ".verify = function(json, opt_path) {")
+	g.In()
+	g.P("var path = opt_path || '';")
+	g.P("if (json === null || typeof json !== 'object' || Array.isArray(json)) {")
+	g.In()
+	g.P("return [", g.helper("verifyError"), "(path, 'an object', json)];")
+	g.Out()
+	g.P("}")
+	g.P("var prefix = path ? path + '.' : '';")
+	g.P("var errors = [];")
+	for _, field := range message.Field {
+		key := g.jsonKey(field)
+		if field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REQUIRED {
+			g.P(g.helper("verifyRequired"), "(errors, json[\"", key, "\"], prefix + \"", key, "\");")
+		}
+		verify, check := "verifyValue", g.verifyCheck(field)
+		if entry := g.mapEntry(field); entry != nil {
+			verify, check = "verifyMap", g.verifyCheck(entry.Field[1])
+		} else if isRepeated(field) {
+			verify = "verifyArray"
+		}
+		if check == "" {
+			if verify == "verifyValue" {
+				continue
+			}
+			check = "null"
+		}
+		g.P(g.helper(verify), "(errors, json[\"", key, "\"], prefix + \"", key, "\", ", check, ");")
+	}
+	g.P("return errors;")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// The helper functions of the verification.
+var verifyHelpers = map[string]jsHelper{
+	"verifyError": {
+		doc: `Describes a JSON value not of the expected type.
+@param {string} path The path of the value, or an empty string.
+@param {string} expected The expected type.
+@param {*} v The value.
+@return {string} The error.`,
+		params: "path, expected, v",
+		body: `var got = v === null ? 'null' : Array.isArray(v) ? 'array' : typeof v;
+if (got === 'string' || got === 'number') {
+	got += ' ' + JSON.stringify(v);
+}
+return (path ? path + ': ' : '') + 'expected ' + expected + ', got ' + got;`,
+	},
+	"verifyValue": {
+		doc: `Checks a JSON value of a singular field, unless unset.
+@param {!Array.<string>} errors The errors, appended to.
+@param {*} v The value.
+@param {string} path The path of the value.
+@param {function(*, string): !Array.<string>} check The check of the value.`,
+		params: "errors, v, path, check",
+		body: `if (v != null) {
+	Array.prototype.push.apply(errors, check(v, path));
+}`,
+	},
+	"verifyArray": {
+		doc: `Checks the JSON array of a repeated field, unless unset.
+@param {!Array.<string>} errors The errors, appended to.
+@param {*} v The array.
+@param {string} path The path of the array.
+@param {?function(*, string): !Array.<string>} check The check of the
+    elements, or null to accept any value.`,
+		params: "errors, v, path, check",
+		body: `if (v == null) {
+	return;
+}
+if (!Array.isArray(v)) {
+	errors.push($(verifyError)(path, 'an array', v));
+	return;
+}
+for (var i = 0; check && i < v.length; i++) {
+	Array.prototype.push.apply(errors, check(v[i], path + '[' + i + ']'));
+}`,
+	},
+	"verifyMap": {
+		doc: `Checks the JSON object of a map field, unless unset.
+@param {!Array.<string>} errors The errors, appended to.
+@param {*} v The object.
+@param {string} path The path of the object.
+@param {?function(*, string): !Array.<string>} check The check of the values,
+    or null to accept any value.`,
+		params: "errors, v, path, check",
+		body: `if (v == null) {
+	return;
+}
+if (typeof v !== 'object' || Array.isArray(v)) {
+	errors.push($(verifyError)(path, 'an object', v));
+	return;
+}
+for (var key in v) {
+	if (check && Object.prototype.hasOwnProperty.call(v, key)) {
+		Array.prototype.push.apply(errors, check(v[key], path + '["' + key + '"]'));
+	}
+}`,
+	},
+	"verifyRequired": {
+		doc: `Checks that the JSON value of a required field is set.
+@param {!Array.<string>} errors The errors, appended to.
+@param {*} v The value.
+@param {string} path The path of the value.`,
+		params: "errors, v, path",
+		body: `if (v == null) {
+	errors.push(path + ': missing required field');
+}`,
+	},
+	"verifyBool": {
+		doc: `Checks that a JSON value is a boolean.
+@param {*} v The value.
+@param {string} path The path of the value.
+@return {!Array.<string>} The errors.`,
+		params: "v, path",
+		body:   `return typeof v === 'boolean' ? [] : [$(verifyError)(path, 'a boolean', v)];`,
+	},
+	"verifyString": {
+		doc: `Checks that a JSON value is a string.
+@param {*} v The value.
+@param {string} path The path of the value.
+@return {!Array.<string>} The errors.`,
+		params: "v, path",
+		body:   `return typeof v === 'string' ? [] : [$(verifyError)(path, 'a string', v)];`,
+	},
+	"verifyNumber": {
+		doc: `Checks that a JSON value is a number.
+@param {*} v The value.
+@param {string} path The path of the value.
+@return {!Array.<string>} The errors.`,
+		params: "v, path",
+		body:   `return typeof v === 'number' ? [] : [$(verifyError)(path, 'a number', v)];`,
+	},
+	"verifyFloat": {
+		doc: `Checks that a JSON value is a number, or "NaN", "Infinity" or "-Infinity".
+@param {*} v The value.
+@param {string} path The path of the value.
+@return {!Array.<string>} The errors.`,
+		params: "v, path",
+		body: `return typeof v === 'number' || v === 'NaN' || v === 'Infinity' || v === '-Infinity' ? [] :
+		[$(verifyError)(path, 'a number', v)];`,
+	},
+	"verifyInteger": {
+		doc: `Returns the check of the JSON values of an integer type.
+@param {number} bits The size of the integers, 32 or 64 bits.
+@param {boolean} unsigned Whether the integers are unsigned.
+@param {boolean} quoted Whether the integers may be decimal strings, as in
+    the canonical JSON mapping.
+@return {function(*, string): !Array.<string>} The check.`,
+		params: "bits, unsigned, quoted",
+		body: `var min = unsigned ? 0 : -Math.pow(2, bits - 1);
+var max = unsigned ? Math.pow(2, bits) - 1 : Math.pow(2, bits - 1) - 1;
+var expected = (unsigned ? 'an unsigned ' : 'a ') + bits + '-bit integer';
+return function(v, path) {
+	if (quoted && bits === 64 && typeof v === 'string') {
+		// Beyond 2^53, the numbers aren't exact.
+		return $(verifyDecimal)(unsigned)(v, path);
+	}
+	var n = quoted && typeof v === 'string' && /^-?[0-9]+$/.test(v) ? Number(v) : v;
+	return typeof n === 'number' && n % 1 === 0 && n >= min && n <= max ? [] :
+			[$(verifyError)(path, expected, v)];
+};`,
+	},
+	"verifyDecimal": {
+		doc: `Returns the check of the JSON values of a 64-bit integer type kept as
+decimal strings.
+@param {boolean} unsigned Whether the integers are unsigned.
+@return {function(*, string): !Array.<string>} The check.`,
+		params: "unsigned",
+		body: `var expected = 'the decimal string of ' + (unsigned ? 'an unsigned' : 'a') + ' 64-bit integer';
+return function(v, path) {
+	var m = typeof v === 'string' ? /^(-?)0*([0-9]+)$/.exec(v) : null;
+	// The largest magnitude of the sign, compared as a string of the same length.
+	var max = unsigned ? (m && m[1] ? '0' : '18446744073709551615') :
+			m && m[1] ? '9223372036854775808' : '9223372036854775807';
+	return m && (m[2].length < max.length || m[2].length === max.length && m[2] <= max) ? [] :
+			[$(verifyError)(path, expected, v)];
+};`,
+	},
+	"verifyObject": {
+		doc: `Checks that a JSON value is an object.
+@param {*} v The value.
+@param {string} path The path of the value.
+@return {!Array.<string>} The errors.`,
+		params: "v, path",
+		body:   `return typeof v === 'object' && !Array.isArray(v) ? [] : [$(verifyError)(path, 'an object', v)];`,
+	},
+	"verifyList": {
+		doc: `Checks that a JSON value is an array.
+@param {*} v The value.
+@param {string} path The path of the value.
+@return {!Array.<string>} The errors.`,
+		params: "v, path",
+		body:   `return Array.isArray(v) ? [] : [$(verifyError)(path, 'an array', v)];`,
+	},
+	"verifyEnum": {
+		doc: `Returns the check of the JSON values of an enum.
+@param {{valueOf: function(string): ?number, isValid: function(number): boolean}}
+    util The helpers of the enum.
+@param {boolean} names Whether the values may be the names of the enum values
+    and the numbers decimal strings, as in the canonical JSON mapping.
+@param {boolean} closed Whether the numbers must be values of the enum.
+@return {function(*, string): !Array.<string>} The check.`,
+		params: "util, names, closed",
+		body: `return function(v, path) {
+	if (names && typeof v === 'string' && !/^-?[0-9]+$/.test(v)) {
+		return util.valueOf(v) !== null ? [] :
+				[$(verifyError)(path, 'a name of a value of the enum', v)];
+	}
+	if (names && typeof v === 'string') {
+		v = Number(v);
+	}
+	if (typeof v !== 'number' || v % 1 !== 0) {
+		return [$(verifyError)(path, names ? 'an enum value name or number' : 'an enum value number', v)];
+	}
+	return !closed || util.isValid(v) ? [] : [$(verifyError)(path, 'a value of the enum', v)];
+};`,
+	},
+}
@@ -0,0 +1,811 @@
+/*
+ * Built-in handling of the well-known types of google/protobuf. Fields of
+ * these types aren't wrapped by generated classes: their values are kept in
+ * the JSON data in the canonical JSON forms of the types, and the accessors
+ * convert them to native JavaScript values. The conversions, and the binary
+ * serialization of the types, are done by helper functions generated in the
+ * files using them.
+ *
+ * jspb uses the same 3-clause BSD license and keeps the original copyright
+ * information from goprotobuf.
+ */
+package generator
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// The JavaScript types of the values of the well-known types, except the
+// wrappers whose values are of the type of the wrapped field.
+var wellKnownTypes = map[string]string{
+	"google.protobuf.Timestamp":   "Date",
+	"google.protobuf.Duration":    "number", // In milliseconds.
+	"google.protobuf.Struct":      "Object.<string, *>",
+	"google.protobuf.Value":       "*",
+	"google.protobuf.ListValue":   "Array.<*>",
+	"google.protobuf.FieldMask":   "Array.<string>",
+	"google.protobuf.Any":         "Object",
+	"google.protobuf.DoubleValue": "",
+	"google.protobuf.FloatValue":  "",
+	"google.protobuf.Int64Value":  "",
+	"google.protobuf.UInt64Value": "",
+	"google.protobuf.Int32Value":  "",
+	"google.protobuf.UInt32Value": "",
+	"google.protobuf.BoolValue":   "",
+	"google.protobuf.StringValue": "",
+	"google.protobuf.BytesValue":  "",
+}
+
+// The types of the value fields of the wrapper types.
+var wrapperTypes = map[string]descriptor.FieldDescriptorProto_Type{
+	"google.protobuf.DoubleValue": descriptor.FieldDescriptorProto_TYPE_DOUBLE,
+	"google.protobuf.FloatValue":  descriptor.FieldDescriptorProto_TYPE_FLOAT,
+	"google.protobuf.Int64Value":  descriptor.FieldDescriptorProto_TYPE_INT64,
+	"google.protobuf.UInt64Value": descriptor.FieldDescriptorProto_TYPE_UINT64,
+	"google.protobuf.Int32Value":  descriptor.FieldDescriptorProto_TYPE_INT32,
+	"google.protobuf.UInt32Value": descriptor.FieldDescriptorProto_TYPE_UINT32,
+	"google.protobuf.BoolValue":   descriptor.FieldDescriptorProto_TYPE_BOOL,
+	"google.protobuf.StringValue": descriptor.FieldDescriptorProto_TYPE_STRING,
+	"google.protobuf.BytesValue":  descriptor.FieldDescriptorProto_TYPE_BYTES,
+}
+
+// The helpers converting the JSON forms of the well-known types from and to
+// the JavaScript values, and reading and writing them in the binary format.
+var (
+	wktFromJSON = map[string]string{
+		"google.protobuf.Timestamp": "timestampFromJSON",
+		"google.protobuf.Duration":  "durationFromJSON",
+		"google.protobuf.FieldMask": "fieldMaskFromJSON",
+	}
+	wktToJSON = map[string]string{
+		"google.protobuf.Duration":  "durationToJSON",
+		"google.protobuf.FieldMask": "fieldMaskToJSON",
+	}
+	wktWriters = map[string]string{
+		"google.protobuf.Timestamp": "writeTimestamp",
+		"google.protobuf.Duration":  "writeDuration",
+		"google.protobuf.Struct":    "writeStruct",
+		"google.protobuf.Value":     "writeValue",
+		"google.protobuf.ListValue": "writeListValue",
+		"google.protobuf.FieldMask": "writeFieldMask",
+		"google.protobuf.Any":       "writeAny",
+	}
+	wktReaders = map[string]string{
+		"google.protobuf.Timestamp": "readTimestamp",
+		"google.protobuf.Duration":  "readDuration",
+		"google.protobuf.Struct":    "readStruct",
+		"google.protobuf.Value":     "readValue",
+		"google.protobuf.ListValue": "readListValue",
+		"google.protobuf.FieldMask": "readFieldMask",
+		"google.protobuf.Any":       "readAny",
+	}
+)
+
+// wellKnownType returns the full name of the well-known type of the field,
+// like "google.protobuf.Timestamp", or "" if the field isn't of a well-known
+// type handled by the generator.
+func wellKnownType(field *descriptor.FieldDescriptorProto) string {
+	if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+		return ""
+	}
+	name := strings.TrimPrefix(field.GetTypeName(), ".")
+	if _, ok := wellKnownTypes[name]; ok {
+		return name
+	}
+	return ""
+}
+
+// isMessage reports whether the field is a message field wrapped by a
+// generated class, that is a message field not of a well-known type.
+func isMessage(field *descriptor.FieldDescriptorProto) bool {
+	return field.GetType() == descriptor.FieldDescriptorProto_TYPE_MESSAGE && wellKnownType(field) == ""
+}
+
+// wrappedField returns the value field of the wrapper type, or nil if the
+// type isn't a wrapper. The wrappers are handled like their value fields.
+func wrappedField(name string) *descriptor.FieldDescriptorProto {
+	typ, ok := wrapperTypes[name]
+	if !ok {
+		return nil
+	}
+	return &descriptor.FieldDescriptorProto{
+		Name:   proto.String("value"),
+		Number: proto.Int32(1),
+		Type:   &typ,
+	}
+}
+
+// wktJsType returns the JavaScript type of a single value of the field of a
+// well-known type.
+func (g *Generator) wktJsType(field *descriptor.FieldDescriptorProto) string {
+	name := wellKnownType(field)
+	if value := wrappedField(name); value != nil {
+		typ, _, _ := g.JsType(nil, value)
+		return typ
+	}
+	return wellKnownTypes[name]
+}
+
+// wktFromJSONExpr returns the JavaScript expression converting expr, a value
+// of the field of a well-known type in the JSON data, into its JavaScript
+// value.
+func (g *Generator) wktFromJSONExpr(field *descriptor.FieldDescriptorProto, expr string) string {
+	name := wellKnownType(field)
+	if value := wrappedField(name); value != nil {
+		return g.fromJSON(value, expr)
+	}
+	if helper, ok := wktFromJSON[name]; ok {
+		return g.helper(helper) + "(" + expr + ")"
+	}
+	return expr
+}
+
+// wktToJSONExpr is the reverse of wktFromJSONExpr. Null is kept as null, so
+// the setters of wrapper fields take null.
+func (g *Generator) wktToJSONExpr(field *descriptor.FieldDescriptorProto, expr string) string {
+	name := wellKnownType(field)
+	conv := expr
+	if value := wrappedField(name); value != nil {
+		conv = g.toJSON(value, expr)
+	} else if name == "google.protobuf.Timestamp" {
+		conv = expr + ".toISOString()"
+	} else if helper, ok := wktToJSON[name]; ok {
+		conv = g.helper(helper) + "(" + expr + ")"
+	}
+	if conv == expr {
+		return expr
+	}
+	return "(" + expr + " == null ? null : " + conv + ")"
+}
+
+// generateWktWriter generates the statement writing expr, the JSON form of a
+// single value of the field of a well-known type, to the "writer" variable.
+func (g *Generator) generateWktWriter(field *descriptor.FieldDescriptorProto, expr string) {
+	name := wellKnownType(field)
+	if value := wrappedField(name); value != nil {
+		g.P("writer.writeMessage(", field.Number, ", ", expr, ", function(__v, writer) {")
+		g.In()
+		g.P("writer.write", g.binaryMethod(value), "(1, ", g.binaryFromJSON(value, "__v"), ");")
+		g.Out()
+		g.P("});")
+		return
+	}
+	if name == "google.protobuf.Value" {
+		// The writer skips null messages, but null is a valid Value.
+		expr = "[" + expr + "]"
+	}
+	g.P("writer.writeMessage(", field.Number, ", ", expr, ", ", g.helper(wktWriters[name]), ");")
+}
+
+// wktReadValue returns the JavaScript expression reading a single value of
+// the field of a well-known type from the "reader" variable, in its JSON form.
+func (g *Generator) wktReadValue(field *descriptor.FieldDescriptorProto) string {
+	name := wellKnownType(field)
+	if value := wrappedField(name); value != nil {
+		return g.helper("readWrapper") + "(reader, function(reader) { return " +
+			g.binaryToJSON(value, g.binaryReadValue(value)) + "; }, " + g.binaryZeroValue(value) + ")"
+	}
+	return g.helper(wktReaders[name]) + "(reader)"
+}
+
+// generateAnyMethods generates the methods packing a message into the Any
+// field and unpacking it, using the registry of the generated classes.
+func (g *Generator) generateAnyMethods(className, packName, unpackName, getter, setter string, field *descriptor.FieldDescriptorProto) {
+	g.declare(className, packName+"(message: {getJsonData(): Object}): void;")
+	g.declare(className, unpackName+"(): Object | null;")
+
+	g.P("/**")
+	g.P(" * Packs the message into the ", field.GetName(), ".")
+	g.P(" * @param {!Object} message The message, of a generated class.")
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" */")
+	g.P(className, ".prototype.", packName, " = function(message) {")
+	g.In()
+	g.P("var __data = {'@type': 'type.googleapis.com/' + message.constructor.TYPE_NAME};")
+	g.P("var __json = message.getJsonData();")
+	g.P("for (var __key in __json) {")
+	g.In()
+	g.P("__data[__key] = __json[__key];")
+	g.Out()
+	g.P("}")
+	g.P("this.", setter, "(__data);")
+	g.Out()
+	g.P("};")
+	g.P()
+
+	g.P("/**")
+	g.P(" * @return {Object} The message packed in the ", field.GetName(), ", or null if it's unset or")
+	g.P(" *     its type isn't registered.")
+	g.printDeprecated(field.GetOptions().GetDeprecated())
+	g.P(" */")
+	g.P(className, ".prototype.", unpackName, " = function() {")
+	g.In()
+	g.P("var v = this.", getter, "();")
+	g.P("var __type = v && ", g.helper("anyType"), "(v['@type'] || '');")
+	g.P("if (!__type) {")
+	g.In()
+	g.P("return null;")
+	g.Out()
+	g.P("}")
+	g.P("var __data = {};")
+	g.P("for (var __key in v) {")
+	g.In()
+	g.P("if (__key !== '@type') {")
+	g.In()
+	g.P("__data[__key] = v[__key];")
+	g.Out()
+	g.P("}")
+	g.Out()
+	g.P("}")
+	g.P("return new __type(__data);")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// anyTypesKey is the property of the global object holding the registry of
+// the message classes packed in google.protobuf.Any, shared by all the
+// generated files whatever their package or module.
+const anyTypesKey = "jspbAnyTypes"
+
+// anyTypes returns the expression of the registry of the message classes
+// packed in google.protobuf.Any.
+func (g *Generator) anyTypes() string {
+	if g.Target == targetESM {
+		return "globalThis['" + anyTypesKey + "']"
+	}
+	return "goog.global['" + anyTypesKey + "']"
+}
+
+// definesRegisterAnyTypes reports whether the current file defines
+// registerAnyTypes. The modules export their own, while the Closure scripts
+// of a package share the one provided by the first of them using Any.
+func (g *Generator) definesRegisterAnyTypes() bool {
+	return g.helpers["anyType"] && (g.Target != targetClosure || g.anyTypesProvider == g.file)
+}
+
+// generateAnyTypes generates the registry of the message classes packed in
+// google.protobuf.Any, unless another file already did, and the function
+// registering them.
+func (g *Generator) generateAnyTypes() {
+	registry := g.anyTypes()
+	g.P("/**")
+	g.P(" * The message classes which can be packed in google.protobuf.Any, by full")
+	g.P(" * name, shared by the generated files.")
+	g.P(" * @type {!Object.<string, !Function>}")
+	g.P(" */")
+	g.P(registry, " = ", registry, " || {};")
+	g.P()
+	if !g.definesRegisterAnyTypes() {
+		return
+	}
+	register := g.topLevelName("registerAnyTypes")
+	g.declareFunction(register, "(...types: Function[]): void")
+	g.P("/**")
+	g.P(" * Registers generated message classes, by their TYPE_NAME, so the")
+	g.P(" * google.protobuf.Any fields of the messages of any generated file can")
+	g.P(" * unpack and serialize them.")
+	g.P(" * @param {...!Function} var_args The classes.")
+	g.P(" */")
+	g.P(g.define(register), " = function(var_args) {")
+	g.In()
+	g.P("for (var i = 0; i < arguments.length; i++) {")
+	g.In()
+	g.P(registry, "[arguments[i].TYPE_NAME] = arguments[i];")
+	g.Out()
+	g.P("}")
+	g.Out()
+	g.P("};")
+	g.P()
+}
+
+// helper returns the expression referring to the named helper function, and
+// records its use so it's generated in the current file. In modules the
+// helpers are private functions; in Closure scripts they are defined in the
+// namespace of the package.
+func (g *Generator) helper(name string) string {
+	if _, ok := jsHelperNamed(name); !ok {
+		g.Fail("internal error: no helper", name)
+	}
+	g.helpers[name] = true
+	return g.privateName(name)
+}
+
+// privateName returns the expression referring to a private object of the
+// generated code: in modules its name; in Closure scripts the name with an
+// underscore appended in the namespace of the package, shared by the files of
+// the package.
+func (g *Generator) privateName(name string) string {
+	if g.Target != targetClosure {
+		return name
+	}
+	ns := g.file.GetPackage()
+	if g.PkgPrefix != "" {
+		ns = strings.TrimSuffix(g.PkgPrefix+"."+ns, ".")
+	}
+	if ns == "" {
+		return name + "_"
+	}
+	return ns + "." + name + "_"
+}
+
+// helperRef matches the references in the helper functions: $(name) refers
+// to another helper, $(ns.Name) to a namespace of a support library.
+var helperRef = regexp.MustCompile(`\$\(([\w.]+)\)`)
+
+// expandHelperRefs replaces the references in the text of a helper function
+// with the expressions referring to them in the current file.
+func (g *Generator) expandHelperRefs(text string) string {
+	return helperRef.ReplaceAllStringFunc(text, func(ref string) string {
+		ref = helperRef.FindStringSubmatch(ref)[1]
+		switch {
+		case ref == "bytesToBase64":
+			return g.bytesToBase64()
+		case ref == "base64ToBytes":
+			return g.base64ToBytes()
+		case ref == "anyTypes":
+			return g.anyTypes()
+		case strings.Contains(ref, "."):
+			return g.use(ref)
+		}
+		return g.helper(ref)
+	})
+}
+
+// generateHelpers generates the helper functions used in the current file,
+// and the ones they use, in the order of their names.
+func (g *Generator) generateHelpers() {
+	texts := make(map[string][2]string)
+	for len(texts) < len(g.helpers) {
+		for name := range g.helpers {
+			if _, ok := texts[name]; !ok {
+				h, _ := jsHelperNamed(name)
+				texts[name] = [2]string{g.expandHelperRefs(h.doc), g.expandHelperRefs(h.body)}
+			}
+		}
+	}
+	var names []string
+	for name := range texts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		doc, body := texts[name][0], texts[name][1]
+		h, _ := jsHelperNamed(name)
+		g.P("/**")
+		for _, line := range strings.Split(doc, "\n") {
+			g.P(" * ", line)
+		}
+		g.P(" */")
+		if g.Target == targetClosure {
+			g.P(g.helper(name), " = function(", h.params, ") {")
+		} else {
+			g.P("function ", name, "(", h.params, ") {")
+		}
+		g.In()
+		for _, line := range strings.Split(body, "\n") {
+			g.P(line)
+		}
+		g.Out()
+		if g.Target == targetClosure {
+			g.P("};")
+		} else {
+			g.P("}")
+		}
+		g.P()
+	}
+}
+
+// A jsHelper is a JavaScript helper function generated in the files using
+// it. The body refers to other helpers as $(name).
+type jsHelper struct {
+	doc    string // The JSDoc, without the comment delimiters.
+	params string
+	body   string
+}
+
+// jsHelperNamed returns the helper function of the given name.
+func jsHelperNamed(name string) (jsHelper, bool) {
+	for _, helpers := range []map[string]jsHelper{enumHelpers, wktHelpers, transportHelpers, extensionHelpers, equalsHelpers, mergeHelpers,
+		fieldMaskHelpers, validateHelpers, verifyHelpers} {
+		if h, ok := helpers[name]; ok {
+			return h, true
+		}
+	}
+	return jsHelper{}, false
+}
+
+// The helper functions of the well-known types.
+var wktHelpers = map[string]jsHelper{
+	"timestampFromJSON": {
+		doc: `Converts a google.protobuf.Timestamp from its JSON form into a Date.
+@param {*} v The RFC 3339 string, or the object with seconds and nanos.
+@return {!Date} The date, truncated to milliseconds.`,
+		params: "v",
+		body: `if (typeof v === 'string') {
+	// Date parses at most three fractional digits.
+	return new Date(v.replace(/(\.\d{3})\d+/, '$1'));
+}
+return new Date(Number(v.seconds || 0) * 1000 + (v.nanos || 0) / 1e6);`,
+	},
+	"durationFromJSON": {
+		doc: `Converts a google.protobuf.Duration from its JSON form into milliseconds.
+@param {*} v The string of seconds with the "s" suffix, or the object with
+    seconds and nanos.
+@return {number} The milliseconds.`,
+		params: "v",
+		body: `if (typeof v === 'string') {
+	return parseFloat(v) * 1000;
+}
+return Number(v.seconds || 0) * 1000 + (v.nanos || 0) / 1e6;`,
+	},
+	"durationToJSON": {
+		doc: `Converts milliseconds into the JSON form of a google.protobuf.Duration.
+@param {number} ms The milliseconds.
+@return {string} The string of seconds with the "s" suffix.`,
+		params: "ms",
+		body:   `return (ms / 1000).toFixed(9).replace(/\.?(000)+$/, '') + 's';`,
+	},
+	"fieldMaskFromJSON": {
+		doc: `Converts a google.protobuf.FieldMask from its JSON form into its paths.
+@param {*} v The comma-separated lowerCamelCase paths, or the object with
+    the paths.
+@return {!Array.<string>} The paths, with the field names of the .proto file.`,
+		params: "v",
+		body: `var paths = typeof v === 'string' ? (v ? v.split(',') : []) : (v.paths || []);
+return paths.map(function(path) {
+	return path.replace(/[A-Z]/g, function(c) {
+		return '_' + c.toLowerCase();
+	});
+});`,
+	},
+	"fieldMaskToJSON": {
+		doc: `Converts the paths of a google.protobuf.FieldMask into its JSON form.
+@param {!Array.<string>} paths The paths, with the field names of the .proto
+    file.
+@return {string} The comma-separated lowerCamelCase paths.`,
+		params: "paths",
+		body: `return paths.map(function(path) {
+	return path.replace(/_([a-z])/g, function(m, c) {
+		return c.toUpperCase();
+	});
+}).join(',');`,
+	},
+	"readSecondsAndNanos": {
+		doc: `Reads a google.protobuf.Timestamp or a google.protobuf.Duration.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {!Array.<number>} The seconds and the nanos.`,
+		params: "reader",
+		body: `var t = [0, 0];
+reader.readMessage(t, function(t, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		switch (reader.getFieldNumber()) {
+		case 1:
+			t[0] = reader.readInt64();
+			break;
+		case 2:
+			t[1] = reader.readInt32();
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+});
+return t;`,
+	},
+	"writeTimestamp": {
+		doc: `Writes a google.protobuf.Timestamp in its JSON form.
+@param {*} v The timestamp.
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "v, writer",
+		body: `var seconds = Number(v.seconds || 0), nanos = v.nanos || 0;
+if (typeof v === 'string') {
+	var fraction = /\.(\d+)/.exec(v);
+	seconds = Math.floor($(timestampFromJSON)(v).getTime() / 1000);
+	nanos = fraction ? Number((fraction[1] + '00000000').substring(0, 9)) : 0;
+}
+writer.writeInt64(1, seconds);
+writer.writeInt32(2, nanos);`,
+	},
+	"readTimestamp": {
+		doc: `Reads a google.protobuf.Timestamp into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {string} The RFC 3339 string.`,
+		params: "reader",
+		body: `var t = $(readSecondsAndNanos)(reader);
+var s = new Date(t[0] * 1000).toISOString().replace(/\.\d+Z$/, '');
+return s + (t[1] ? '.' + String(1e9 + t[1]).substring(1).replace(/(000)+$/, '') : '') + 'Z';`,
+	},
+	"writeDuration": {
+		doc: `Writes a google.protobuf.Duration in its JSON form.
+@param {*} v The duration.
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "v, writer",
+		body: `var seconds = Number(v.seconds || 0), nanos = v.nanos || 0;
+if (typeof v === 'string') {
+	var m = /^(-?)(\d*)(?:\.(\d+))?s$/.exec(v) || ['', '', '', ''];
+	seconds = Number(m[2] || 0);
+	nanos = m[3] ? Number((m[3] + '00000000').substring(0, 9)) : 0;
+	if (m[1]) {
+		seconds = -seconds;
+		nanos = -nanos;
+	}
+}
+writer.writeInt64(1, seconds);
+writer.writeInt32(2, nanos);`,
+	},
+	"readDuration": {
+		doc: `Reads a google.protobuf.Duration into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {string} The string of seconds with the "s" suffix.`,
+		params: "reader",
+		body: `var t = $(readSecondsAndNanos)(reader);
+var fraction = t[1] ? '.' + String(1e9 + Math.abs(t[1])).substring(1).replace(/(000)+$/, '') : '';
+return (t[0] < 0 || t[1] < 0 ? '-' : '') + Math.abs(t[0]) + fraction + 's';`,
+	},
+	"readWrapper": {
+		doc: `Reads a wrapper, like google.protobuf.Int32Value, into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@param {function(!$(jspb.BinaryReader)): *} read Reads the JSON form of the
+    value field.
+@param {*} zero The JSON form of the zero value.
+@return {*} The JSON form of the value.`,
+		params: "reader, read, zero",
+		body: `var wrapper = {value: zero};
+reader.readMessage(wrapper, function(wrapper, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		if (reader.getFieldNumber() === 1) {
+			wrapper.value = read(reader);
+		} else {
+			reader.skipField();
+		}
+	}
+});
+return wrapper.value;`,
+	},
+	"writeStruct": {
+		doc: `Writes a google.protobuf.Struct in its JSON form.
+@param {!Object.<string, *>} v The object.
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "v, writer",
+		body: `for (var key in v) {
+	writer.writeMessage(1, key, function(key, writer) {
+		writer.writeString(1, key);
+		writer.writeMessage(2, [v[key]], $(writeValue));
+	});
+}`,
+	},
+	"readStruct": {
+		doc: `Reads a google.protobuf.Struct into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {!Object.<string, *>} The object.`,
+		params: "reader",
+		body: `var struct = {};
+reader.readMessage(struct, function(struct, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		if (reader.getFieldNumber() !== 1) {
+			reader.skipField();
+			continue;
+		}
+		var entry = {key: '', value: null};
+		reader.readMessage(entry, function(entry, reader) {
+			while (reader.nextField()) {
+				if (reader.isEndGroup()) {
+					break;
+				}
+				switch (reader.getFieldNumber()) {
+				case 1:
+					entry.key = reader.readString();
+					break;
+				case 2:
+					entry.value = $(readValue)(reader);
+					break;
+				default:
+					reader.skipField();
+				}
+			}
+		});
+		struct[entry.key] = entry.value;
+	}
+});
+return struct;`,
+	},
+	"writeValue": {
+		doc: `Writes a google.protobuf.Value in its JSON form.
+@param {!Array} value The JSON value, boxed in an array as the writer skips
+    null messages.
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "value, writer",
+		body: `var v = value[0];
+if (v === null) {
+	writer.writeEnum(1, 0);
+} else if (typeof v === 'number') {
+	writer.writeDouble(2, v);
+} else if (typeof v === 'string') {
+	writer.writeString(3, v);
+} else if (typeof v === 'boolean') {
+	writer.writeBool(4, v);
+} else if (Array.isArray(v)) {
+	writer.writeMessage(6, v, $(writeListValue));
+} else {
+	writer.writeMessage(5, v, $(writeStruct));
+}`,
+	},
+	"readValue": {
+		doc: `Reads a google.protobuf.Value into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {*} The JSON value.`,
+		params: "reader",
+		body: `var value = [null];
+reader.readMessage(value, function(value, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		switch (reader.getFieldNumber()) {
+		case 1:
+			reader.readEnum();
+			value[0] = null;
+			break;
+		case 2:
+			value[0] = reader.readDouble();
+			break;
+		case 3:
+			value[0] = reader.readString();
+			break;
+		case 4:
+			value[0] = reader.readBool();
+			break;
+		case 5:
+			value[0] = $(readStruct)(reader);
+			break;
+		case 6:
+			value[0] = $(readListValue)(reader);
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+});
+return value[0];`,
+	},
+	"writeListValue": {
+		doc: `Writes a google.protobuf.ListValue in its JSON form.
+@param {!Array.<*>} v The array.
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "v, writer",
+		body: `v.forEach(function(item) {
+	writer.writeMessage(1, [item], $(writeValue));
+});`,
+	},
+	"readListValue": {
+		doc: `Reads a google.protobuf.ListValue into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {!Array.<*>} The array.`,
+		params: "reader",
+		body: `var list = [];
+reader.readMessage(list, function(list, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		if (reader.getFieldNumber() === 1) {
+			list.push($(readValue)(reader));
+		} else {
+			reader.skipField();
+		}
+	}
+});
+return list;`,
+	},
+	"writeFieldMask": {
+		doc: `Writes a google.protobuf.FieldMask in its JSON form.
+@param {*} v The field mask.
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "v, writer",
+		body: `$(fieldMaskFromJSON)(v).forEach(function(path) {
+	writer.writeString(1, path);
+});`,
+	},
+	"readFieldMask": {
+		doc: `Reads a google.protobuf.FieldMask into its JSON form.
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {string} The comma-separated lowerCamelCase paths.`,
+		params: "reader",
+		body: `var paths = [];
+reader.readMessage(paths, function(paths, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		if (reader.getFieldNumber() === 1) {
+			paths.push(reader.readString());
+		} else {
+			reader.skipField();
+		}
+	}
+});
+return $(fieldMaskToJSON)(paths);`,
+	},
+	"anyType": {
+		doc: `Looks up the registered class of the message type of a type URL.
+@param {string} url The type URL of a google.protobuf.Any.
+@return {?Function} The class, or null if it isn't registered.`,
+		params: "url",
+		body: `var name = url.substring(url.lastIndexOf('/') + 1);
+return Object.prototype.hasOwnProperty.call($(anyTypes), name) ? $(anyTypes)[name] : null;`,
+	},
+	"writeAny": {
+		doc: `Writes a google.protobuf.Any in its JSON form. The packed message is
+serialized by its registered class; the value of an unregistered type is
+written as is.
+@param {!Object} v The JSON object with the type URL in "@type".
+@param {!$(jspb.BinaryWriter)} writer The writer.`,
+		params: "v, writer",
+		body: `var url = v['@type'] || '';
+var type = $(anyType)(url);
+writer.writeString(1, url);
+if (type) {
+	var data = {};
+	for (var key in v) {
+		if (key !== '@type') {
+			data[key] = v[key];
+		}
+	}
+	writer.writeBytes(2, new type(data).serializeBinary());
+} else if (v['value'] != null) {
+	writer.writeBytes(2, v['value']);
+}`,
+	},
+	"readAny": {
+		doc: `Reads a google.protobuf.Any into its JSON form. The packed message is
+deserialized by its registered class; the value of an unregistered type is
+kept as a base64 string in "value".
+@param {!$(jspb.BinaryReader)} reader The reader.
+@return {!Object} The JSON object with the type URL in "@type".`,
+		params: "reader",
+		body: `var any = {url: '', bytes: null};
+reader.readMessage(any, function(any, reader) {
+	while (reader.nextField()) {
+		if (reader.isEndGroup()) {
+			break;
+		}
+		switch (reader.getFieldNumber()) {
+		case 1:
+			any.url = reader.readString();
+			break;
+		case 2:
+			any.bytes = reader.readBytes();
+			break;
+		default:
+			reader.skipField();
+		}
+	}
+});
+var v = {'@type': any.url};
+var type = $(anyType)(any.url);
+if (type && any.bytes) {
+	var data = type.deserializeBinary(any.bytes).getJsonData();
+	for (var key in data) {
+		v[key] = data[key];
+	}
+} else if (any.bytes) {
+	v['value'] = $(bytesToBase64)(any.bytes);
+}
+return v;`,
+	},
+}
@@ -8,7 +8,9 @@
 package main
 
 import (
+	"errors"
 	"io/ioutil"
+	"log"
 	"os"
 
 	"github.com/golang/protobuf/proto"
@@ -20,36 +22,54 @@ func main() {
 
 	data, err := ioutil.ReadAll(os.Stdin)
 	if err != nil {
-		g.Error(err, "reading input")
+		log.Fatal("protoc-gen-js: error: reading input: ", err)
 	}
 
 	// Parse the request.
 	if err := proto.Unmarshal(data, g.Request); err != nil {
-		g.Error(err, "parsing input proto")
+		log.Fatal("protoc-gen-js: error: parsing input proto: ", err)
 	}
 
-	if len(g.Request.FileToGenerate) == 0 {
-		g.Fail("no files to generate")
+	if err := generate(g); err != nil {
+		// Report the problem to protoc, which prints it and fails.
+		g.Response.Error = proto.String(err.Error())
+		g.Response.File = nil
 	}
 
-	g.CommandLineParameters(g.Request.GetParameter())
-
-	// Create a wrapped version of the Descriptors and EnumDescriptors that
-	// point to the file that defines them.
-	g.WrapTypes()
-
-	g.SetPackageNames()
-	g.BuildTypeNameMap()
-
-	g.GenerateAllFiles()
-
 	// Send back the results.
 	data, err = proto.Marshal(g.Response)
 	if err != nil {
-		g.Error(err, "failed to marshal output proto")
+		log.Fatal("protoc-gen-js: error: failed to marshal output proto: ", err)
 	}
 	_, err = os.Stdout.Write(data)
 	if err != nil {
-		g.Error(err, "failed to write output proto")
+		log.Fatal("protoc-gen-js: error: failed to write output proto: ", err)
+	}
+}
+
+// generate fills the response of the generator with the code generated for
+// its request.
+func generate(g *generator.Generator) error {
+	if len(g.Request.FileToGenerate) == 0 {
+		return errors.New("no files to generate")
 	}
+
+	if err := g.CommandLineParameters(g.Request.GetParameter()); err != nil {
+		return err
+	}
+
+	// Create a wrapped version of the Descriptors and EnumDescriptors that
+	// point to the file that defines them.
+	if err := g.WrapTypes(); err != nil {
+		return err
+	}
+
+	if err := g.SetPackageNames(); err != nil {
+		return err
+	}
+	if err := g.BuildTypeNameMap(); err != nil {
+		return err
+	}
+
+	return g.GenerateAllFiles()
 }